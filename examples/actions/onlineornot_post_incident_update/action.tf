@@ -0,0 +1,9 @@
+action "onlineornot_post_incident_update" "fix_deployed" {
+  config {
+    status_page_id     = onlineornot_status_page.public.id
+    incident_id        = var.incident_id
+    status             = "MONITORING"
+    message            = "A fix has been deployed and we are monitoring the results."
+    notify_subscribers = true
+  }
+}
@@ -0,0 +1,18 @@
+action "onlineornot_run_check" "smoke_test" {
+  config {
+    check_id = onlineornot_uptime_check.api.id
+    wait     = true
+  }
+}
+
+# Run the smoke test every time the API deployment changes
+resource "terraform_data" "api_release" {
+  input = var.api_version
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.onlineornot_run_check.smoke_test]
+    }
+  }
+}
@@ -0,0 +1,8 @@
+data "onlineornot_account" "current" {}
+
+# Run every check at the shortest interval the plan allows
+resource "onlineornot_uptime_check" "api" {
+  name          = "API"
+  url           = "https://api.example.com/health"
+  test_interval = data.onlineornot_account.current.quotas.min_test_interval
+}
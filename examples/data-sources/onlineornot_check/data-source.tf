@@ -0,0 +1,8 @@
+# Look up a check by name
+data "onlineornot_check" "api" {
+  name = "API health"
+}
+
+output "api_check_status" {
+  value = data.onlineornot_check.api.status
+}
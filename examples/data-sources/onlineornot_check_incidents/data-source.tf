@@ -0,0 +1,10 @@
+# Outages of the API check during the week of an incident review
+data "onlineornot_check_incidents" "api" {
+  check_id = onlineornot_check.api.id
+  since    = "2026-03-02T00:00:00Z"
+  until    = "2026-03-09T00:00:00Z"
+}
+
+output "api_outages" {
+  value = [for incident in data.onlineornot_check_incidents.api.incidents : "${incident.started_at}: ${incident.reason}"]
+}
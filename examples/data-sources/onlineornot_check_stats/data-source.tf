@@ -0,0 +1,19 @@
+# Uptime of the API check over the last 30 days
+data "onlineornot_check_stats" "api" {
+  check_id = onlineornot_check.api.id
+}
+
+output "api_uptime" {
+  value = data.onlineornot_check_stats.api.uptime_percentage
+}
+
+# Response times for January 2026
+data "onlineornot_check_stats" "january" {
+  check_id = onlineornot_check.api.id
+  since    = "2026-01-01T00:00:00Z"
+  until    = "2026-02-01T00:00:00Z"
+}
+
+output "api_p95_by_region" {
+  value = { for rt in data.onlineornot_check_stats.january.response_times : rt.region => rt.p95 }
+}
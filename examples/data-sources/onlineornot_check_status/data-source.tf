@@ -0,0 +1,17 @@
+# Fail the plan while any production check is down
+data "onlineornot_check_status" "production" {
+  check_ids = [
+    onlineornot_check.api.id,
+    onlineornot_check.web.id,
+  ]
+}
+
+# Only warn, and expose the result for other conditions
+data "onlineornot_check_status" "staging" {
+  check_ids = [onlineornot_check.staging.id]
+  warn_only = true
+}
+
+output "staging_healthy" {
+  value = data.onlineornot_check_status.staging.ok
+}
@@ -0,0 +1,13 @@
+# Every production API check that runs from aws:us-east-1
+data "onlineornot_checks" "api" {
+  name_regex  = "^api-prod-"
+  test_region = "aws:us-east-1"
+}
+
+# Add all of them to a status page
+resource "onlineornot_status_page_component" "api" {
+  for_each = { for check in data.onlineornot_checks.api.checks : check.id => check }
+
+  status_page_id = var.status_page_id
+  name           = each.value.name
+}
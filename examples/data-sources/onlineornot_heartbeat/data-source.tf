@@ -0,0 +1,8 @@
+# Look up a heartbeat by name
+data "onlineornot_heartbeat" "nightly_backup" {
+  name = "Nightly backup"
+}
+
+output "backup_grace_period" {
+  value = data.onlineornot_heartbeat.nightly_backup.grace_period
+}
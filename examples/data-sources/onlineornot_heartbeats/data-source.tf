@@ -0,0 +1,8 @@
+# Heartbeats that are currently down
+data "onlineornot_heartbeats" "down" {
+  status = "DOWN"
+}
+
+output "down_heartbeats" {
+  value = [for hb in data.onlineornot_heartbeats.down.heartbeats : hb.name]
+}
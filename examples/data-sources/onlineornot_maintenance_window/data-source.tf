@@ -0,0 +1,8 @@
+# Look up a maintenance window by name
+data "onlineornot_maintenance_window" "weekly" {
+  name = "Weekly database maintenance"
+}
+
+output "maintenance_days" {
+  value = data.onlineornot_maintenance_window.weekly.days_of_week
+}
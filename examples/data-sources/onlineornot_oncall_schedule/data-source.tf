@@ -0,0 +1,10 @@
+data "onlineornot_oncall_schedule" "primary" {
+  name = "Primary on-call"
+}
+
+resource "onlineornot_heartbeat" "nightly_backup" {
+  name         = "Nightly backup"
+  grace_period = 3600
+
+  oncall_alerts = [data.onlineornot_oncall_schedule.primary.id]
+}
@@ -0,0 +1,12 @@
+data "onlineornot_regions" "all" {}
+
+# Run the check from every European region that supports browser checks
+resource "onlineornot_browser_check" "checkout" {
+  name = "Checkout"
+  url  = "https://example.com/checkout"
+
+  test_regions = [
+    for region in data.onlineornot_regions.all.regions : region.id
+    if region.continent == "Europe" && region.supports_browser_checks
+  ]
+}
@@ -0,0 +1,10 @@
+data "onlineornot_slack_channel" "alerts" {
+  name = "#alerts"
+}
+
+resource "onlineornot_check" "api" {
+  name = "API"
+  url  = "https://api.example.com/health"
+
+  slack_alerts = [data.onlineornot_slack_channel.alerts.id]
+}
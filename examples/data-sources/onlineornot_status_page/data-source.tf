@@ -0,0 +1,9 @@
+# Look up a status page by subdomain
+data "onlineornot_status_page" "public" {
+  subdomain = "status"
+}
+
+resource "onlineornot_status_page_component" "api" {
+  status_page_id = data.onlineornot_status_page.public.id
+  name           = "API"
+}
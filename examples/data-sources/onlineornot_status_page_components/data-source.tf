@@ -0,0 +1,8 @@
+# Components of a status page, e.g. to attach them to incidents
+data "onlineornot_status_page_components" "example" {
+  status_page_id = onlineornot_status_page.example.id
+}
+
+output "component_ids" {
+  value = { for comp in data.onlineornot_status_page_components.example.status_page_components : comp.name => comp.id }
+}
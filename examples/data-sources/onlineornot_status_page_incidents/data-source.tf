@@ -0,0 +1,9 @@
+# Incidents on a status page that have not been resolved yet
+data "onlineornot_status_page_incidents" "open" {
+  status_page_id = onlineornot_status_page.example.id
+  status         = "UNRESOLVED"
+}
+
+output "open_incidents" {
+  value = [for incident in data.onlineornot_status_page_incidents.open.status_page_incidents : incident.title]
+}
@@ -0,0 +1,8 @@
+# Look up a webhook by the URL it delivers to
+data "onlineornot_webhook" "pagerduty" {
+  url = "https://events.example.com/onlineornot"
+}
+
+output "webhook_events" {
+  value = data.onlineornot_webhook.pagerduty.events
+}
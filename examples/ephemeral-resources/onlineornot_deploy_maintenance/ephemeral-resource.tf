@@ -0,0 +1,8 @@
+# Silence alerts for the API check and the worker heartbeat while this
+# configuration is being applied
+ephemeral "onlineornot_deploy_maintenance" "deploy" {
+  name             = "API deploy"
+  checks           = [onlineornot_uptime_check.api.id]
+  heartbeats       = [onlineornot_heartbeat.worker.id]
+  duration_minutes = 15
+}
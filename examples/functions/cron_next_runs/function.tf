@@ -0,0 +1,3 @@
+output "backup_schedule" {
+  value = provider::onlineornot::cron_next_runs("0 3 * * *", "Europe/London", plantimestamp(), 5)
+}
@@ -0,0 +1,9 @@
+resource "onlineornot_heartbeat" "backup" {
+  name          = "Nightly backup"
+  report_period = 86400
+  grace_period  = 3600
+}
+
+output "backup_ping_url" {
+  value = provider::onlineornot::heartbeat_ping_url(onlineornot_heartbeat.backup.id)
+}
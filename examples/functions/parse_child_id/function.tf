@@ -0,0 +1,7 @@
+locals {
+  component = provider::onlineornot::parse_child_id("a1b2c3d4/e5f6g7h8")
+}
+
+output "status_page_id" {
+  value = local.component.status_page_id
+}
@@ -0,0 +1,8 @@
+resource "onlineornot_status_page" "public" {
+  name      = "Acme Status"
+  subdomain = "acme"
+}
+
+output "status_page_url" {
+  value = provider::onlineornot::status_page_url(onlineornot_status_page.public.subdomain, onlineornot_status_page.public.custom_domain)
+}
@@ -0,0 +1,9 @@
+list "onlineornot_browser_check" "all" {
+  provider = onlineornot
+
+  include_resource = true
+
+  config {
+    name_prefix = "checkout-"
+  }
+}
@@ -0,0 +1,9 @@
+list "onlineornot_dns_check" "all" {
+  provider = onlineornot
+
+  include_resource = true
+
+  config {
+    record_type = "MX"
+  }
+}
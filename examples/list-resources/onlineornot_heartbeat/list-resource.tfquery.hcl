@@ -0,0 +1,9 @@
+list "onlineornot_heartbeat" "all" {
+  provider = onlineornot
+
+  include_resource = true
+
+  config {
+    name_prefix = "backup-"
+  }
+}
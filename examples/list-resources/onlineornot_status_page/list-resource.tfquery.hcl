@@ -0,0 +1,9 @@
+list "onlineornot_status_page" "all" {
+  provider = onlineornot
+
+  include_resource = true
+
+  config {
+    name_prefix = "Acme"
+  }
+}
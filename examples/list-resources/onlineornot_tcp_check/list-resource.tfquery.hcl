@@ -0,0 +1,9 @@
+list "onlineornot_tcp_check" "all" {
+  provider = onlineornot
+
+  include_resource = true
+
+  config {
+    name_prefix = "db-"
+  }
+}
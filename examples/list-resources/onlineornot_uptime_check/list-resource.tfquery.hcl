@@ -0,0 +1,10 @@
+list "onlineornot_uptime_check" "all" {
+  provider = onlineornot
+
+  include_resource = true
+
+  config {
+    name_prefix = "prod-"
+    status      = "DOWN"
+  }
+}
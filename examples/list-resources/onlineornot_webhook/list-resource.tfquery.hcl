@@ -0,0 +1,9 @@
+list "onlineornot_webhook" "all" {
+  provider = onlineornot
+
+  include_resource = true
+
+  config {
+    url_prefix = "https://hooks.example.com/"
+  }
+}
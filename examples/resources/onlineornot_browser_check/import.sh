@@ -0,0 +1,6 @@
+# Import by check ID
+terraform import onlineornot_browser_check.example 3f2a9c1e
+
+# Import by exact check name or URL
+terraform import onlineornot_browser_check.example "name=Checkout flow"
+terraform import onlineornot_browser_check.example "url=https://example.com/checkout"
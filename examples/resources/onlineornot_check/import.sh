@@ -0,0 +1,6 @@
+# Import by check ID
+terraform import onlineornot_check.example 3f2a9c1e
+
+# Import by exact check name or URL
+terraform import onlineornot_check.example "name=Marketing site"
+terraform import onlineornot_check.example "url=https://example.com"
@@ -0,0 +1,6 @@
+# Import by check ID
+terraform import onlineornot_dns_check.example 3f2a9c1e
+
+# Import by exact check name or domain
+terraform import onlineornot_dns_check.example "name=Apex A record"
+terraform import onlineornot_dns_check.example "domain=example.com"
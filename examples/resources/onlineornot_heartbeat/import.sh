@@ -0,0 +1,5 @@
+# Import by heartbeat ID
+terraform import onlineornot_heartbeat.example 7b1d04aa
+
+# Import by exact heartbeat name
+terraform import onlineornot_heartbeat.example "name=Nightly backup"
@@ -0,0 +1,6 @@
+# Import by status page ID
+terraform import onlineornot_status_page.example 9e4c2b71
+
+# Import by exact status page name or subdomain
+terraform import onlineornot_status_page.example "name=Acme Status"
+terraform import onlineornot_status_page.example "subdomain=status"
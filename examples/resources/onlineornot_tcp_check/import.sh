@@ -0,0 +1,6 @@
+# Import by check ID
+terraform import onlineornot_tcp_check.example 3f2a9c1e
+
+# Import by exact check name or hostname
+terraform import onlineornot_tcp_check.example "name=Postgres primary"
+terraform import onlineornot_tcp_check.example "hostname=db.example.com"
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"sync"
+)
+
+// Account represents the organisation the API key belongs to, with the
+// limits of its plan and how much of them is in use
+type Account struct {
+	OrganisationName string        `json:"organisation_name"`
+	Plan             string        `json:"plan"`
+	Quotas           AccountQuotas `json:"quotas"`
+	Usage            AccountUsage  `json:"usage"`
+}
+
+// AccountQuotas represents the limits of a plan. A zero count means the
+// plan does not limit that kind of object.
+type AccountQuotas struct {
+	Checks          int `json:"checks"`
+	BrowserChecks   int `json:"browser_checks"`
+	Heartbeats      int `json:"heartbeats"`
+	StatusPages     int `json:"status_pages"`
+	MinTestInterval int `json:"min_test_interval"`
+}
+
+// AccountUsage represents the number of objects counted against the quotas
+type AccountUsage struct {
+	Checks        int `json:"checks"`
+	BrowserChecks int `json:"browser_checks"`
+	Heartbeats    int `json:"heartbeats"`
+	StatusPages   int `json:"status_pages"`
+}
+
+// accountState memoises the account and counts the objects a plan creates
+// and destroys against its quotas
+type accountState struct {
+	mu       sync.Mutex
+	account  *Account
+	reserved map[string]int
+}
+
+// GetAccount retrieves the account of the API key. A successful result is
+// kept for the life of the client, since plans and quotas do not change
+// during a Terraform run and every planned check consults them. A failed
+// fetch is not kept, so the next call tries again.
+func (c *Client) GetAccount(ctx context.Context) (*Account, error) {
+	c.accountState.mu.Lock()
+	defer c.accountState.mu.Unlock()
+
+	if c.accountState.account != nil {
+		return c.accountState.account, nil
+	}
+	respBody, err := c.Get(ctx, "/v1/account")
+	if err != nil {
+		return nil, err
+	}
+	account, err := parseAPIResponse[Account](respBody)
+	if err != nil {
+		return nil, err
+	}
+	c.accountState.account = account
+	return account, nil
+}
+
+// ReserveQuota records that the current plan creates one more object of the
+// given kind, e.g. "checks", and returns the net number of objects of that
+// kind the plan has created so far, including this one
+func (c *Client) ReserveQuota(kind string) int {
+	return c.adjustQuota(kind, 1)
+}
+
+// ReleaseQuota records that the current plan destroys an object of the given
+// kind, freeing its place for objects created later in the plan
+func (c *Client) ReleaseQuota(kind string) {
+	c.adjustQuota(kind, -1)
+}
+
+func (c *Client) adjustQuota(kind string, delta int) int {
+	c.accountState.mu.Lock()
+	defer c.accountState.mu.Unlock()
+
+	if c.accountState.reserved == nil {
+		c.accountState.reserved = map[string]int{}
+	}
+	c.accountState.reserved[kind] += delta
+	return c.accountState.reserved[kind]
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// readCache holds the results of top-level list endpoints so that data
+// sources, lookups and imports that need the same list share one paginated
+// fetch. Single-object reads always use the detail endpoint, since list items
+// are not guaranteed to carry every field the detail response does. Entries
+// are keyed by list path and dropped whenever the client writes to that path
+// or anything beneath it.
+type readCache struct {
+	mu         sync.Mutex
+	group      singleflight.Group
+	entries    map[string]*cacheEntry
+	generation map[string]uint64
+}
+
+type cacheEntry struct {
+	items []json.RawMessage
+}
+
+func newReadCache() *readCache {
+	return &readCache{
+		entries:    map[string]*cacheEntry{},
+		generation: map[string]uint64{},
+	}
+}
+
+// load returns the cached entry for listPath, warming it with fetch on first
+// use. Concurrent callers for the same path share a single fetch, unless a
+// write happened in between: the fetch is keyed by the path's generation, so
+// a caller never receives a list that was requested before its own write.
+func (rc *readCache) load(listPath string, fetch func() ([]json.RawMessage, error)) (*cacheEntry, error) {
+	rc.mu.Lock()
+	if entry, ok := rc.entries[listPath]; ok {
+		rc.mu.Unlock()
+		return entry, nil
+	}
+	generation := rc.generation[listPath]
+	rc.mu.Unlock()
+
+	key := listPath + "#" + strconv.FormatUint(generation, 10)
+	v, err, _ := rc.group.Do(key, func() (interface{}, error) {
+		items, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+
+		entry := &cacheEntry{items: items}
+
+		// Only keep the result if nothing was written while it was in flight
+		rc.mu.Lock()
+		if rc.generation[listPath] == generation {
+			rc.entries[listPath] = entry
+		}
+		rc.mu.Unlock()
+
+		return entry, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*cacheEntry), nil
+}
+
+// invalidate drops every cached list that path belongs to
+func (rc *readCache) invalidate(path string) {
+	if i := strings.Index(path, "?"); i >= 0 {
+		path = path[:i]
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	for listPath := range rc.generation {
+		if path == listPath || strings.HasPrefix(path, listPath+"/") {
+			delete(rc.entries, listPath)
+			rc.generation[listPath]++
+		}
+	}
+}
+
+// track registers listPath so that writes beneath it invalidate the entry
+func (rc *readCache) track(listPath string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if _, ok := rc.generation[listPath]; !ok {
+		rc.generation[listPath] = 0
+	}
+}
+
+// listCached lists every object at listPath, serving the result from the read
+// cache when it is enabled.
+func listCached[T any](ctx context.Context, c *Client, listPath string) ([]T, error) {
+	if c.cache == nil {
+		return listAll[T](ctx, c, listPath)
+	}
+
+	c.cache.track(listPath)
+	entry, err := c.cache.load(listPath, func() ([]json.RawMessage, error) {
+		return c.getAllPages(ctx, listPath)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return decodeItems[T](entry.items)
+}
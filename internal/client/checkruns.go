@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// Check run statuses reported while a run has not finished yet
+const (
+	CheckRunStatusQueued  = "QUEUED"
+	CheckRunStatusRunning = "RUNNING"
+)
+
+// Check run statuses reported once a run has finished
+const (
+	CheckRunStatusUp   = "UP"
+	CheckRunStatusDown = "DOWN"
+)
+
+// CheckRun represents an on-demand run of a check
+type CheckRun struct {
+	ID           string `json:"id"`
+	CheckID      string `json:"check_id,omitempty"`
+	Status       string `json:"status"`
+	ResponseTime int    `json:"response_time,omitempty"`
+	Error        string `json:"error,omitempty"`
+	StartedAt    string `json:"started_at,omitempty"`
+	CompletedAt  string `json:"completed_at,omitempty"`
+}
+
+// Done reports whether the run has finished and Status holds its result. An
+// empty or unrecognised status is not treated as finished.
+func (r *CheckRun) Done() bool {
+	return r.Status == CheckRunStatusUp || r.Status == CheckRunStatusDown
+}
+
+// RunCheck queues an immediate run of a check outside its schedule
+func (c *Client) RunCheck(ctx context.Context, checkID string) (*CheckRun, error) {
+	respBody, err := c.Post(ctx, fmt.Sprintf("/v1/checks/%s/run", checkID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseAPIResponse[CheckRun](respBody)
+}
+
+// GetCheckRun retrieves an on-demand check run
+func (c *Client) GetCheckRun(ctx context.Context, checkID, runID string) (*CheckRun, error) {
+	respBody, err := c.Get(ctx, fmt.Sprintf("/v1/checks/%s/runs/%s", checkID, runID))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseAPIResponse[CheckRun](respBody)
+}
@@ -1,8 +1,10 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // Check represents an uptime check
@@ -51,50 +53,42 @@ type Assertion struct {
 }
 
 // CreateCheck creates a new uptime check
-func (c *Client) CreateCheck(check *Check) (*Check, error) {
-	return c.CreateTypedCheck("", check)
+func (c *Client) CreateCheck(ctx context.Context, check *Check) (*Check, error) {
+	return c.CreateTypedCheck(ctx, "", check)
 }
 
 // CreateTypedCheck creates a check using a typed check endpoint when kind is set.
-func (c *Client) CreateTypedCheck(kind string, check *Check) (*Check, error) {
+func (c *Client) CreateTypedCheck(ctx context.Context, kind string, check *Check) (*Check, error) {
 	path := "/v1/checks"
 	if kind != "" {
 		path = fmt.Sprintf("/v1/checks/%s", kind)
 	}
 
-	respBody, err := c.Post(path, check)
-	if err != nil {
-		return nil, err
-	}
-
-	var apiResp APIResponse[Check]
-	if err := json.Unmarshal(respBody, &apiResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	if !apiResp.Success {
-		if len(apiResp.Errors) > 0 {
-			return nil, fmt.Errorf("API error: %s", apiResp.Errors[0].Message)
+	return createIdempotent[Check](ctx, c, path, check, func() ([]string, error) {
+		checks, err := listCached[Check](ctx, c, "/v1/checks")
+		if err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("API request failed")
-	}
-
-	return &apiResp.Result, nil
+		return MatchingIDs(checks, func(existing Check) string { return existing.ID }, func(existing Check) bool {
+			return existing.Name == check.Name && existing.URL == check.URL &&
+				(kind == "" || existing.CheckType == strings.ToUpper(kind))
+		}), nil
+	})
 }
 
 // GetCheck retrieves a check by ID
-func (c *Client) GetCheck(id string) (*Check, error) {
-	return c.GetTypedCheck("", id)
+func (c *Client) GetCheck(ctx context.Context, id string) (*Check, error) {
+	return c.GetTypedCheck(ctx, "", id)
 }
 
 // GetTypedCheck retrieves a check using a typed check endpoint when kind is set.
-func (c *Client) GetTypedCheck(kind string, id string) (*Check, error) {
+func (c *Client) GetTypedCheck(ctx context.Context, kind string, id string) (*Check, error) {
 	path := fmt.Sprintf("/v1/checks/%s", id)
 	if kind != "" {
 		path = fmt.Sprintf("/v1/checks/%s/%s", kind, id)
 	}
 
-	respBody, err := c.Get(path)
+	respBody, err := c.Get(ctx, path)
 	if err != nil {
 		return nil, err
 	}
@@ -115,18 +109,18 @@ func (c *Client) GetTypedCheck(kind string, id string) (*Check, error) {
 }
 
 // UpdateCheck updates an existing check
-func (c *Client) UpdateCheck(id string, check *Check) (*Check, error) {
-	return c.UpdateTypedCheck("", id, check)
+func (c *Client) UpdateCheck(ctx context.Context, id string, check *Check) (*Check, error) {
+	return c.UpdateTypedCheck(ctx, "", id, check)
 }
 
 // UpdateTypedCheck updates a check using a typed check endpoint when kind is set.
-func (c *Client) UpdateTypedCheck(kind string, id string, check *Check) (*Check, error) {
+func (c *Client) UpdateTypedCheck(ctx context.Context, kind string, id string, check *Check) (*Check, error) {
 	path := fmt.Sprintf("/v1/checks/%s", id)
 	if kind != "" {
 		path = fmt.Sprintf("/v1/checks/%s/%s", kind, id)
 	}
 
-	respBody, err := c.Patch(path, check)
+	respBody, err := c.Patch(ctx, path, check)
 	if err != nil {
 		return nil, err
 	}
@@ -147,39 +141,22 @@ func (c *Client) UpdateTypedCheck(kind string, id string, check *Check) (*Check,
 }
 
 // DeleteCheck deletes a check
-func (c *Client) DeleteCheck(id string) error {
-	return c.DeleteTypedCheck("", id)
+func (c *Client) DeleteCheck(ctx context.Context, id string) error {
+	return c.DeleteTypedCheck(ctx, "", id)
 }
 
 // DeleteTypedCheck deletes a check using a typed check endpoint when kind is set.
-func (c *Client) DeleteTypedCheck(kind string, id string) error {
+func (c *Client) DeleteTypedCheck(ctx context.Context, kind string, id string) error {
 	path := fmt.Sprintf("/v1/checks/%s", id)
 	if kind != "" {
 		path = fmt.Sprintf("/v1/checks/%s/%s", kind, id)
 	}
 
-	_, err := c.Delete(path)
+	_, err := c.Delete(ctx, path)
 	return err
 }
 
 // ListChecks retrieves all checks
-func (c *Client) ListChecks() ([]Check, error) {
-	respBody, err := c.Get("/v1/checks")
-	if err != nil {
-		return nil, err
-	}
-
-	var apiResp APIListResponse[Check]
-	if err := json.Unmarshal(respBody, &apiResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	if !apiResp.Success {
-		if len(apiResp.Errors) > 0 {
-			return nil, fmt.Errorf("API error: %s", apiResp.Errors[0].Message)
-		}
-		return nil, fmt.Errorf("API request failed")
-	}
-
-	return apiResp.Result, nil
+func (c *Client) ListChecks(ctx context.Context) ([]Check, error) {
+	return listCached[Check](ctx, c, "/v1/checks")
 }
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// CheckStats represents uptime and response time statistics for a check
+// over a time window
+type CheckStats struct {
+	CheckID          string                `json:"check_id"`
+	Since            string                `json:"since"`
+	Until            string                `json:"until"`
+	UptimePercentage float64               `json:"uptime_percentage"`
+	DowntimeMinutes  int                   `json:"downtime_minutes"`
+	IncidentCount    int                   `json:"incident_count"`
+	ResponseTimes    []RegionResponseTimes `json:"response_times,omitempty"`
+}
+
+// RegionResponseTimes represents the response time percentiles, in
+// milliseconds, measured from a single test region
+type RegionResponseTimes struct {
+	Region string `json:"region"`
+	P50    int    `json:"p50"`
+	P90    int    `json:"p90"`
+	P95    int    `json:"p95"`
+	P99    int    `json:"p99"`
+}
+
+// GetCheckStats retrieves the statistics of a check between since and until
+func (c *Client) GetCheckStats(ctx context.Context, checkID string, since, until time.Time) (*CheckStats, error) {
+	respBody, err := c.Get(ctx, fmt.Sprintf("/v1/checks/%s/stats?%s", checkID, timeWindowQuery(since, until)))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseAPIResponse[CheckStats](respBody)
+}
+
+// timeWindowQuery encodes a time window as since and until query parameters
+func timeWindowQuery(since, until time.Time) string {
+	return url.Values{
+		"since": {since.UTC().Format(time.RFC3339)},
+		"until": {until.UTC().Format(time.RFC3339)},
+	}.Encode()
+}
@@ -2,16 +2,23 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
 const (
-	DefaultBaseURL = "https://api.onlineornot.com"
-	UserAgent      = "terraform-provider-onlineornot/1.0.0"
+	DefaultBaseURL               = "https://api.onlineornot.com"
+	UserAgent                    = "terraform-provider-onlineornot/1.0.0"
+	DefaultRequestsPerSecond     = 10
+	DefaultMaxConcurrentRequests = 5
 )
 
 // Client is the OnlineOrNot API client
@@ -19,12 +26,35 @@ type Client struct {
 	BaseURL    string
 	APIKey     string
 	HTTPClient *http.Client
+
+	// limiter and slots are shared by every caller of the client, so all
+	// resources and data sources of a provider instance draw from the same
+	// request budget.
+	limiter *rate.Limiter
+	slots   chan struct{}
+
+	// cache is nil unless Config.ReadCache is set
+	cache *readCache
+
+	// retryBackoff is the base delay between attempts of a retried create
+	retryBackoff time.Duration
+
+	accountState accountState
 }
 
 // Config holds the configuration for the client
 type Config struct {
 	APIKey  string
 	BaseURL string
+	// RequestsPerSecond caps the sustained request rate. Zero uses
+	// DefaultRequestsPerSecond; a negative value disables rate limiting.
+	RequestsPerSecond float64
+	// MaxConcurrentRequests caps the number of in-flight requests. Zero uses
+	// DefaultMaxConcurrentRequests; a negative value disables the cap.
+	MaxConcurrentRequests int
+	// ReadCache serves Get and List calls for top-level objects from a
+	// shared, lazily warmed list cache that is invalidated on writes.
+	ReadCache bool
 }
 
 // NewClient creates a new OnlineOrNot API client
@@ -34,13 +64,21 @@ func NewClient(config *Config) *Client {
 		baseURL = DefaultBaseURL
 	}
 
-	return &Client{
+	c := &Client{
 		BaseURL: baseURL,
 		APIKey:  config.APIKey,
 		HTTPClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		limiter:      newRateLimiter(config.RequestsPerSecond),
+		slots:        newConcurrencySlots(config.MaxConcurrentRequests),
+		retryBackoff: time.Second,
+	}
+	if config.ReadCache {
+		c.cache = newReadCache()
 	}
+
+	return c
 }
 
 // APIResponse represents the standard OnlineOrNot API response wrapper
@@ -82,8 +120,61 @@ type APIMessage struct {
 	Type    string `json:"type,omitempty"`
 }
 
+// RequestError is returned when the API responds with an error status code
+type RequestError struct {
+	StatusCode int
+	Code       int
+	Message    string
+	Body       string
+}
+
+func (e *RequestError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("API error: %s (code: %d)", e.Message, e.Code)
+	}
+	return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// transportError is returned when a request was sent but no complete
+// response was received, so the server may or may not have applied it.
+type transportError struct {
+	msg string
+	err error
+}
+
+func (e *transportError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err)
+}
+
+func (e *transportError) Unwrap() error {
+	return e.err
+}
+
+// isAmbiguous reports whether err leaves it unknown whether the server
+// applied the request.
+func isAmbiguous(err error) bool {
+	var reqErr *RequestError
+	if errors.As(err, &reqErr) {
+		return reqErr.StatusCode >= 500
+	}
+	var tErr *transportError
+	return errors.As(err, &tErr)
+}
+
 // doRequest performs an HTTP request with authentication
-func (c *Client) doRequest(method, path string, body interface{}) ([]byte, error) {
+func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	return c.doRequestWithHeaders(ctx, method, path, body, nil)
+}
+
+// doRequestWithHeaders performs an HTTP request with authentication and the
+// given extra headers. Cancelling ctx abandons the request, including any
+// wait for the rate limiter.
+func (c *Client) doRequestWithHeaders(ctx context.Context, method, path string, body interface{}, headers map[string]string) ([]byte, error) {
+	if c.cache != nil && method != http.MethodGet {
+		// Invalidate even if the request fails, as the server may have applied it
+		defer c.cache.invalidate(path)
+	}
+
 	var reqBody io.Reader
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
@@ -94,7 +185,7 @@ func (c *Client) doRequest(method, path string, body interface{}) ([]byte, error
 	}
 
 	url := fmt.Sprintf("%s%s", c.BaseURL, path)
-	req, err := http.NewRequest(method, url, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -103,50 +194,132 @@ func (c *Client) doRequest(method, path string, body interface{}) ([]byte, error
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", UserAgent)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	release, err := c.acquire(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("request throttled: %w", err)
+	}
+	defer release()
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, &transportError{msg: "request failed", err: err}
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, &transportError{msg: "failed to read response body", err: err}
 	}
 
 	if resp.StatusCode >= 400 {
+		reqErr := &RequestError{StatusCode: resp.StatusCode, Body: string(respBody)}
 		var apiResp APIResponse[interface{}]
 		if err := json.Unmarshal(respBody, &apiResp); err == nil && len(apiResp.Errors) > 0 {
-			return nil, fmt.Errorf("API error: %s (code: %d)", apiResp.Errors[0].Message, apiResp.Errors[0].Code)
+			reqErr.Code = apiResp.Errors[0].Code
+			reqErr.Message = apiResp.Errors[0].Message
 		}
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
+		return nil, reqErr
 	}
 
 	return respBody, nil
 }
 
 // Get performs a GET request
-func (c *Client) Get(path string) ([]byte, error) {
-	return c.doRequest(http.MethodGet, path, nil)
+func (c *Client) Get(ctx context.Context, path string) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodGet, path, nil)
 }
 
 // Post performs a POST request
-func (c *Client) Post(path string, body interface{}) ([]byte, error) {
-	return c.doRequest(http.MethodPost, path, body)
+func (c *Client) Post(ctx context.Context, path string, body interface{}) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodPost, path, body)
 }
 
 // Patch performs a PATCH request
-func (c *Client) Patch(path string, body interface{}) ([]byte, error) {
-	return c.doRequest(http.MethodPatch, path, body)
+func (c *Client) Patch(ctx context.Context, path string, body interface{}) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodPatch, path, body)
 }
 
 // Put performs a PUT request
-func (c *Client) Put(path string, body interface{}) ([]byte, error) {
-	return c.doRequest(http.MethodPut, path, body)
+func (c *Client) Put(ctx context.Context, path string, body interface{}) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodPut, path, body)
 }
 
 // Delete performs a DELETE request
-func (c *Client) Delete(path string) ([]byte, error) {
-	return c.doRequest(http.MethodDelete, path, nil)
+func (c *Client) Delete(ctx context.Context, path string) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodDelete, path, nil)
+}
+
+// listPageSize is the page size requested when walking paginated list endpoints
+const listPageSize = 100
+
+// getAllPages fetches every page of a list endpoint and returns the raw
+// result items in the order the API returned them.
+func (c *Client) getAllPages(ctx context.Context, path string) ([]json.RawMessage, error) {
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+
+	var items []json.RawMessage
+	for page := 1; ; page++ {
+		respBody, err := c.Get(ctx, fmt.Sprintf("%s%spage=%d&per_page=%d", path, separator, page, listPageSize))
+		if err != nil {
+			return nil, err
+		}
+
+		var apiResp APIListResponse[json.RawMessage]
+		if err := json.Unmarshal(respBody, &apiResp); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		if !apiResp.Success {
+			if len(apiResp.Errors) > 0 {
+				return nil, fmt.Errorf("API error: %s", apiResp.Errors[0].Message)
+			}
+			return nil, fmt.Errorf("API request failed")
+		}
+
+		info := apiResp.ResultInfo
+		if page > 1 && info.Page != 0 && info.Page != page {
+			// The endpoint ignores the page parameter and returned the first page again
+			return items, nil
+		}
+
+		items = append(items, apiResp.Result...)
+
+		switch {
+		case len(apiResp.Result) == 0:
+			return items, nil
+		case info.TotalCount > 0 && len(items) >= info.TotalCount:
+			return items, nil
+		case info.TotalCount == 0 && len(apiResp.Result) < listPageSize:
+			return items, nil
+		}
+	}
+}
+
+// decodeItems unmarshals raw list items into T
+func decodeItems[T any](items []json.RawMessage) ([]T, error) {
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		var v T
+		if err := json.Unmarshal(item, &v); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+// listAll fetches every page of a list endpoint
+func listAll[T any](ctx context.Context, c *Client, path string) ([]T, error) {
+	items, err := c.getAllPages(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return decodeItems[T](items)
 }
@@ -1,10 +1,16 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 // newTestServer creates a mock HTTP server for testing
@@ -51,7 +57,7 @@ func TestClient_GetCheck(t *testing.T) {
 	})
 	defer server.Close()
 
-	result, err := client.GetCheck("abc123")
+	result, err := client.GetCheck(context.Background(), "abc123")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -109,7 +115,7 @@ func TestClient_CreateCheck(t *testing.T) {
 	})
 	defer server.Close()
 
-	result, err := client.CreateCheck(input)
+	result, err := client.CreateCheck(context.Background(), input)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -157,7 +163,7 @@ func TestClient_CreateTypedCheck(t *testing.T) {
 	})
 	defer server.Close()
 
-	result, err := client.CreateTypedCheck("browser", input)
+	result, err := client.CreateTypedCheck(context.Background(), "browser", input)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -202,7 +208,7 @@ func TestClient_CreateDNSCheck(t *testing.T) {
 	})
 	defer server.Close()
 
-	result, err := client.CreateDNSCheck(input)
+	result, err := client.CreateDNSCheck(context.Background(), input)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -240,7 +246,7 @@ func TestClient_UpdateCheck(t *testing.T) {
 	})
 	defer server.Close()
 
-	result, err := client.UpdateCheck("abc123", input)
+	result, err := client.UpdateCheck(context.Background(), "abc123", input)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -268,7 +274,7 @@ func TestClient_UpdateTypedCheck(t *testing.T) {
 	})
 	defer server.Close()
 
-	result, err := client.UpdateTypedCheck("uptime", "abc123", input)
+	result, err := client.UpdateTypedCheck(context.Background(), "uptime", "abc123", input)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -295,7 +301,7 @@ func TestClient_UpdateTCPCheck(t *testing.T) {
 	})
 	defer server.Close()
 
-	result, err := client.UpdateTCPCheck("tcp123", input)
+	result, err := client.UpdateTCPCheck(context.Background(), "tcp123", input)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -322,7 +328,7 @@ func TestClient_DeleteCheck(t *testing.T) {
 	})
 	defer server.Close()
 
-	err := client.DeleteCheck("abc123")
+	err := client.DeleteCheck(context.Background(), "abc123")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -346,7 +352,7 @@ func TestClient_DeleteTypedCheck(t *testing.T) {
 	})
 	defer server.Close()
 
-	if err := client.DeleteTypedCheck("uptime", "abc123"); err != nil {
+	if err := client.DeleteTypedCheck(context.Background(), "uptime", "abc123"); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
@@ -380,7 +386,7 @@ func TestClient_ListChecks(t *testing.T) {
 	})
 	defer server.Close()
 
-	result, err := client.ListChecks()
+	result, err := client.ListChecks(context.Background())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -390,6 +396,36 @@ func TestClient_ListChecks(t *testing.T) {
 	}
 }
 
+func TestClient_ListAllChecks(t *testing.T) {
+	server, client := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"success": true, "result_info": {"page": 1, "per_page": 20, "count": 3, "total_count": 3}, "result": [
+			{"id": "c1", "name": "Site", "check_type": "UPTIME", "url": "https://example.com"},
+			{"id": "c2", "name": "Apex", "check_type": "DNS", "dns_domain": "example.com", "dns_record_type": "A"},
+			{"id": "c3", "name": "Postgres", "check_type": "TCP", "tcp_hostname": "db.example.com", "tcp_port": 5432}
+		]}`))
+	})
+	defer server.Close()
+
+	result, err := client.ListAllChecks(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 3 {
+		t.Fatalf("expected 3 checks, got %d", len(result))
+	}
+
+	if result[0].URL != "https://example.com" || result[0].DNS != nil || result[0].TCP != nil {
+		t.Errorf("unexpected uptime check %+v", result[0])
+	}
+	if result[1].Name != "Apex" || result[1].DNS == nil || result[1].DNS.DNSDomain != "example.com" || result[1].TCP != nil {
+		t.Errorf("unexpected DNS check %+v", result[1])
+	}
+	if result[2].TCP == nil || result[2].TCP.TCPPort != 5432 || result[2].DNS != nil {
+		t.Errorf("unexpected TCP check %+v", result[2])
+	}
+}
+
 func TestClient_APIError(t *testing.T) {
 	server, client := newTestServer(func(w http.ResponseWriter, r *http.Request) {
 		resp := APIResponse[Check]{
@@ -404,7 +440,7 @@ func TestClient_APIError(t *testing.T) {
 	})
 	defer server.Close()
 
-	_, err := client.GetCheck("nonexistent")
+	_, err := client.GetCheck(context.Background(), "nonexistent")
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -414,3 +450,663 @@ func TestClient_APIError(t *testing.T) {
 		t.Errorf("expected error message %q, got %q", expectedMsg, err.Error())
 	}
 }
+
+func TestClient_MaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			seen := atomic.LoadInt32(&maxInFlight)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(APIListResponse[Check]{Success: true})
+	}))
+	defer server.Close()
+
+	client := NewClient(&Config{
+		APIKey:                "test-api-key",
+		BaseURL:               server.URL,
+		RequestsPerSecond:     -1,
+		MaxConcurrentRequests: 2,
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.ListChecks(context.Background()); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Errorf("expected at most 2 concurrent requests, got %d", maxInFlight)
+	}
+}
+
+func TestClient_ThrottledRequestCancelled(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var startedOnce sync.Once
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		startedOnce.Do(func() { close(started) })
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(APIResponse[Check]{Success: true})
+	}))
+	defer server.Close()
+	// Unblock the handler before the server is closed
+	defer close(release)
+
+	client := NewClient(&Config{
+		APIKey:                "test-api-key",
+		BaseURL:               server.URL,
+		RequestsPerSecond:     -1,
+		MaxConcurrentRequests: 1,
+	})
+
+	go client.GetCheck(context.Background(), "blocking")
+	<-started
+
+	// The only slot is taken, so this request waits until its context ends
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.Get(ctx, "/v1/checks/waiting"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the throttled request to end with its context, got %v", err)
+	}
+}
+
+func TestClient_RequestsPerSecond(t *testing.T) {
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(APIListResponse[Check]{Success: true})
+	}))
+	defer server.Close()
+
+	client := NewClient(&Config{
+		APIKey:                "test-api-key",
+		BaseURL:               server.URL,
+		RequestsPerSecond:     20,
+		MaxConcurrentRequests: -1,
+	})
+
+	// The first 20 requests use the initial burst; the next 10 must wait
+	// for the bucket to refill at 20 tokens per second.
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		if _, err := client.ListChecks(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	elapsed := time.Since(start)
+
+	if requests != 30 {
+		t.Errorf("expected 30 requests, got %d", requests)
+	}
+	if elapsed < 400*time.Millisecond {
+		t.Errorf("expected requests to be throttled, finished in %s", elapsed)
+	}
+}
+
+func TestClient_ListChecks_Pagination(t *testing.T) {
+	server, client := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+
+		var checks []Check
+		switch page {
+		case "1":
+			for i := 0; i < listPageSize; i++ {
+				checks = append(checks, Check{ID: "page1"})
+			}
+		case "2":
+			checks = []Check{{ID: "page2"}}
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+
+		resp := APIListResponse[Check]{
+			Result:     checks,
+			Success:    true,
+			ResultInfo: ResultInfo{TotalCount: listPageSize + 1},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+	defer server.Close()
+
+	result, err := client.ListChecks(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != listPageSize+1 {
+		t.Fatalf("expected %d checks, got %d", listPageSize+1, len(result))
+	}
+	if result[listPageSize].ID != "page2" {
+		t.Errorf("expected last check from page 2, got %s", result[listPageSize].ID)
+	}
+}
+
+func TestClient_ReadCache(t *testing.T) {
+	var listCalls, getCalls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/checks":
+			atomic.AddInt32(&listCalls, 1)
+			json.NewEncoder(w).Encode(APIListResponse[Check]{
+				Success: true,
+				Result: []Check{
+					{ID: "uptime1", Name: "Uptime 1", CheckType: "UPTIME"},
+					{ID: "uptime2", Name: "Uptime 2", CheckType: "UPTIME"},
+				},
+			})
+		case r.Method == http.MethodGet:
+			atomic.AddInt32(&getCalls, 1)
+			json.NewEncoder(w).Encode(APIResponse[Check]{
+				Success: true,
+				Result:  Check{ID: "uptime1", Name: "Uptime 1", CheckType: "UPTIME", Headers: map[string]string{"X-Detail": "only"}},
+			})
+		case r.Method == http.MethodPatch:
+			json.NewEncoder(w).Encode(APIResponse[Check]{Success: true, Result: Check{ID: "uptime1"}})
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(&Config{
+		APIKey:    "test-api-key",
+		BaseURL:   server.URL,
+		ReadCache: true,
+	})
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checks, err := client.ListChecks(context.Background())
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if len(checks) != 2 {
+				t.Errorf("expected 2 checks, got %d", len(checks))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if listCalls != 1 {
+		t.Fatalf("expected 1 list call, got %d", listCalls)
+	}
+
+	// Single-object reads always use the detail endpoint, which may carry
+	// fields the list does not
+	check, err := client.GetTypedCheck(context.Background(), "uptime", "uptime1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if getCalls != 1 || check.Headers["X-Detail"] != "only" {
+		t.Errorf("expected the detail response from 1 get call, got %+v after %d", check, getCalls)
+	}
+
+	// Writes invalidate the cached list
+	if _, err := client.UpdateTypedCheck(context.Background(), "uptime", "uptime1", &Check{Name: "Renamed"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.ListChecks(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if listCalls != 2 {
+		t.Errorf("expected cache to be re-warmed after a write, got %d list calls", listCalls)
+	}
+}
+
+func TestClient_ReadCache_InvalidatedDuringFetch(t *testing.T) {
+	var listCalls int32
+	firstListStarted := make(chan struct{})
+	releaseFirstList := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case http.MethodGet:
+			name := "Renamed"
+			if atomic.AddInt32(&listCalls, 1) == 1 {
+				// The first list was requested before the rename
+				close(firstListStarted)
+				<-releaseFirstList
+				name = "Original"
+			}
+			json.NewEncoder(w).Encode(APIListResponse[Check]{
+				Success: true,
+				Result:  []Check{{ID: "uptime1", Name: name, CheckType: "UPTIME"}},
+			})
+		case http.MethodPatch:
+			json.NewEncoder(w).Encode(APIResponse[Check]{Success: true, Result: Check{ID: "uptime1", Name: "Renamed"}})
+		}
+	}))
+	defer server.Close()
+	var release sync.Once
+	// Unblock the first list even if the test fails early, so the server
+	// can shut down
+	defer release.Do(func() { close(releaseFirstList) })
+
+	client := NewClient(&Config{
+		APIKey:    "test-api-key",
+		BaseURL:   server.URL,
+		ReadCache: true,
+	})
+
+	staleRead := make(chan error, 1)
+	go func() {
+		_, err := client.ListChecks(context.Background())
+		staleRead <- err
+	}()
+	<-firstListStarted
+
+	if _, err := client.UpdateTypedCheck(context.Background(), "uptime", "uptime1", &Check{Name: "Renamed"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A read after the write must not join the fetch that started before it
+	fresh := make(chan []Check, 1)
+	go func() {
+		checks, err := client.ListChecks(context.Background())
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		fresh <- checks
+	}()
+	select {
+	case checks := <-fresh:
+		if len(checks) != 1 || checks[0].Name != "Renamed" {
+			t.Errorf("expected the renamed check, got %+v", checks)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("read after a write waited for the list fetched before it")
+	}
+
+	release.Do(func() { close(releaseFirstList) })
+	if err := <-staleRead; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The stale list is not cached over the fresh one
+	checks, err := client.ListChecks(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(checks) != 1 || checks[0].Name != "Renamed" || listCalls != 2 {
+		t.Errorf("expected the cached renamed check after 2 list calls, got %+v after %d", checks, listCalls)
+	}
+}
+
+func TestClient_CreateCheck_RetriesWithSameIdempotencyKey(t *testing.T) {
+	var keys []string
+
+	server, client := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		w.Header().Set("Content-Type", "application/json")
+		if len(keys) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(APIResponse[Check]{Result: Check{ID: "abc123"}, Success: true})
+	})
+	defer server.Close()
+	client.retryBackoff = time.Millisecond
+
+	result, err := client.CreateCheck(context.Background(), &Check{Name: "New Check", URL: "https://example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ID != "abc123" {
+		t.Errorf("expected ID abc123, got %s", result.ID)
+	}
+
+	if len(keys) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(keys))
+	}
+	if keys[0] == "" || keys[0] != keys[1] || keys[1] != keys[2] {
+		t.Errorf("expected the same idempotency key on every attempt, got %v", keys)
+	}
+}
+
+func TestClient_CreateCheck_AmbiguousFailureReportsCandidates(t *testing.T) {
+	var posts int
+
+	server, client := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			posts++
+			w.WriteHeader(http.StatusGatewayTimeout)
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(APIListResponse[Check]{
+				Success: true,
+				Result: []Check{
+					{ID: "chk1", Name: "Other Check", URL: "https://example.com"},
+					{ID: "chk2", Name: "New Check", URL: "https://example.com"},
+				},
+			})
+		}
+	})
+	defer server.Close()
+	client.retryBackoff = time.Millisecond
+
+	// A same-name check may belong to someone else, so it must not be adopted
+	result, err := client.CreateCheck(context.Background(), &Check{Name: "New Check", URL: "https://example.com"})
+	if err == nil {
+		t.Fatalf("expected an error, got check %s", result.ID)
+	}
+	if !strings.Contains(err.Error(), "chk2") || strings.Contains(err.Error(), "chk1") {
+		t.Errorf("expected the error to list only the matching check, got: %s", err)
+	}
+	if posts != createAttempts {
+		t.Errorf("expected %d attempts, got %d", createAttempts, posts)
+	}
+}
+
+func TestClient_CreateCheck_RetryStopsWhenContextCancelled(t *testing.T) {
+	var posts int
+	ctx, cancel := context.WithCancel(context.Background())
+
+	server, client := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		posts++
+		cancel()
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	defer server.Close()
+	client.retryBackoff = time.Hour
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.CreateCheck(ctx, &Check{Name: "New Check", URL: "https://example.com"})
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("expected an error, got nil")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("create kept retrying after the context was cancelled")
+	}
+	if posts != 1 {
+		t.Errorf("expected 1 attempt, got %d", posts)
+	}
+}
+
+func TestClient_CreateCheck_ValidationErrorNotRetried(t *testing.T) {
+	var posts int
+
+	server, client := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		posts++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(APIResponse[Check]{Errors: []APIError{{Code: 1002, Message: "Invalid URL"}}})
+	})
+	defer server.Close()
+	client.retryBackoff = time.Millisecond
+
+	_, err := client.CreateCheck(context.Background(), &Check{Name: "New Check", URL: "not a url"})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if posts != 1 {
+		t.Errorf("expected 1 attempt, got %d", posts)
+	}
+}
+
+func TestClient_RunCheck(t *testing.T) {
+	server, client := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/checks/abc123/run":
+			json.NewEncoder(w).Encode(APIResponse[CheckRun]{Result: CheckRun{ID: "run1", Status: CheckRunStatusQueued}, Success: true})
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/checks/abc123/runs/run1":
+			json.NewEncoder(w).Encode(APIResponse[CheckRun]{Result: CheckRun{ID: "run1", Status: "UP", ResponseTime: 120}, Success: true})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	run, err := client.RunCheck(context.Background(), "abc123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if run.ID != "run1" || run.Done() {
+		t.Errorf("expected a queued run1, got %+v", run)
+	}
+
+	run, err = client.GetCheckRun(context.Background(), "abc123", "run1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !run.Done() || run.Status != "UP" {
+		t.Errorf("expected a finished UP run, got %+v", run)
+	}
+}
+
+func TestCheckRun_Done(t *testing.T) {
+	cases := map[string]bool{
+		CheckRunStatusQueued:  false,
+		CheckRunStatusRunning: false,
+		"":                    false,
+		"SOMETHING_NEW":       false,
+		CheckRunStatusUp:      true,
+		CheckRunStatusDown:    true,
+	}
+
+	for status, want := range cases {
+		run := CheckRun{ID: "run1", Status: status}
+		if got := run.Done(); got != want {
+			t.Errorf("Done() with status %q = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestClient_GetCheckStats(t *testing.T) {
+	server, client := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/checks/abc123/stats" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("since"); got != "2026-01-01T00:00:00Z" {
+			t.Errorf("since = %q, want 2026-01-01T00:00:00Z", got)
+		}
+		if got := r.URL.Query().Get("until"); got != "2026-01-31T00:00:00Z" {
+			t.Errorf("until = %q, want 2026-01-31T00:00:00Z", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(APIResponse[CheckStats]{Result: CheckStats{
+			CheckID:          "abc123",
+			UptimePercentage: 99.95,
+			DowntimeMinutes:  21,
+			IncidentCount:    2,
+			ResponseTimes:    []RegionResponseTimes{{Region: "us-east-1", P50: 80, P99: 450}},
+		}, Success: true})
+	})
+	defer server.Close()
+
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	stats, err := client.GetCheckStats(context.Background(), "abc123", since, since.AddDate(0, 0, 30))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stats.UptimePercentage != 99.95 || stats.IncidentCount != 2 || len(stats.ResponseTimes) != 1 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestClient_ListCheckIncidents(t *testing.T) {
+	server, client := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/checks/abc123/incidents" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("since") != "2026-01-01T00:00:00Z" || query.Get("until") != "2026-01-08T00:00:00Z" || query.Get("page") != "1" {
+			t.Errorf("unexpected query %v", query)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(APIListResponse[CheckIncident]{
+			Result: []CheckIncident{
+				{ID: "inc1", StartedAt: "2026-01-02T10:00:00Z", EndedAt: "2026-01-02T10:05:00Z", DurationSeconds: 300, Regions: []string{"us-east-1"}, Reason: "HTTP 503"},
+			},
+			Success:    true,
+			ResultInfo: ResultInfo{Page: 1, PerPage: 100, Count: 1, TotalCount: 1},
+		})
+	})
+	defer server.Close()
+
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	incidents, err := client.ListCheckIncidents(context.Background(), "abc123", since, since.AddDate(0, 0, 7))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(incidents) != 1 || incidents[0].Reason != "HTTP 503" {
+		t.Errorf("unexpected incidents %+v", incidents)
+	}
+}
+
+func TestClient_CreateStatusPageIncidentUpdate(t *testing.T) {
+	server, client := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/status_pages/sp1/incidents/inc1/updates" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get(IdempotencyKeyHeader) == "" {
+			t.Error("expected an idempotency key")
+		}
+
+		var reqBody StatusPageIncidentUpdate
+		json.NewDecoder(r.Body).Decode(&reqBody)
+		reqBody.ID = "upd1"
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(APIResponse[StatusPageIncidentUpdate]{Result: reqBody, Success: true})
+	})
+	defer server.Close()
+
+	result, err := client.CreateStatusPageIncidentUpdate(context.Background(), "sp1", "inc1", &StatusPageIncidentUpdate{Status: "MONITORING", Description: "Fix deployed"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ID != "upd1" || result.Status != "MONITORING" {
+		t.Errorf("unexpected update %+v", result)
+	}
+}
+
+func TestClient_PauseResume(t *testing.T) {
+	var paths []string
+	server, client := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		paths = append(paths, r.URL.Path)
+
+		status := StatusPaused
+		if strings.HasSuffix(r.URL.Path, "/resume") {
+			status = "PENDING"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(APIResponse[MonitorStatus]{Result: MonitorStatus{ID: "abc123", Status: status}, Success: true})
+	})
+	defer server.Close()
+
+	result, err := client.PauseCheck(context.Background(), "abc123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Status != StatusPaused {
+		t.Errorf("expected status %s, got %s", StatusPaused, result.Status)
+	}
+	if _, err := client.ResumeHeartbeat(context.Background(), "abc123"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(paths) != 2 || paths[0] != "/v1/checks/abc123/pause" || paths[1] != "/v1/heartbeats/abc123/resume" {
+		t.Errorf("unexpected request paths %v", paths)
+	}
+}
+
+func TestDefaultRegions(t *testing.T) {
+	regions := DefaultRegions()
+	if len(regions) == 0 {
+		t.Fatal("expected the embedded region list to be non-empty")
+	}
+	for _, region := range regions {
+		if region.ID == "" || region.Provider == "" || region.Continent == "" {
+			t.Errorf("incomplete region %+v", region)
+		}
+	}
+
+	regions[0].ID = "modified"
+	if DefaultRegions()[0].ID == "modified" {
+		t.Error("DefaultRegions returned a shared slice")
+	}
+}
+
+func TestClient_GetAccount(t *testing.T) {
+	var requests atomic.Int32
+	server, client := newTestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/account" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(APIResponse[Account]{Result: Account{
+			OrganisationName: "Acme",
+			Plan:             "Starter",
+			Quotas:           AccountQuotas{Checks: 50, MinTestInterval: 60},
+			Usage:            AccountUsage{Checks: 48},
+		}, Success: true})
+	})
+	defer server.Close()
+
+	if _, err := client.GetAccount(context.Background()); err == nil {
+		t.Fatal("expected an error from the failed fetch")
+	}
+	for range 3 {
+		account, err := client.GetAccount(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if account.Plan != "Starter" || account.Quotas.Checks != 50 || account.Usage.Checks != 48 {
+			t.Errorf("unexpected account %+v", account)
+		}
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected the failed fetch to be retried once and the account then kept, got %d requests", got)
+	}
+
+	if client.ReserveQuota("checks") != 1 || client.ReserveQuota("checks") != 2 || client.ReserveQuota("browser_checks") != 1 {
+		t.Error("expected reservations to be counted per kind")
+	}
+	client.ReleaseQuota("checks")
+	if got := client.ReserveQuota("checks"); got != 2 {
+		t.Errorf("expected a released check to free a reservation, got %d", got)
+	}
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -12,6 +13,8 @@ type Heartbeat struct {
 	ReportPeriod                 int      `json:"report_period,omitempty"`
 	ReportPeriodCron             string   `json:"report_period_cron,omitempty"`
 	GracePeriod                  int      `json:"grace_period"`
+	Status                       string   `json:"status,omitempty"`
+	PingURL                      string   `json:"ping_url,omitempty"`
 	Timezone                     string   `json:"timezone,omitempty"`
 	AlertPriority                string   `json:"alert_priority,omitempty"`
 	ReminderAlertIntervalMinutes int      `json:"reminder_alert_interval_minutes,omitempty"`
@@ -25,30 +28,21 @@ type Heartbeat struct {
 }
 
 // CreateHeartbeat creates a new heartbeat
-func (c *Client) CreateHeartbeat(hb *Heartbeat) (*Heartbeat, error) {
-	respBody, err := c.Post("/v1/heartbeats", hb)
-	if err != nil {
-		return nil, err
-	}
-
-	var apiResp APIResponse[Heartbeat]
-	if err := json.Unmarshal(respBody, &apiResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	if !apiResp.Success {
-		if len(apiResp.Errors) > 0 {
-			return nil, fmt.Errorf("API error: %s", apiResp.Errors[0].Message)
+func (c *Client) CreateHeartbeat(ctx context.Context, hb *Heartbeat) (*Heartbeat, error) {
+	return createIdempotent[Heartbeat](ctx, c, "/v1/heartbeats", hb, func() ([]string, error) {
+		heartbeats, err := c.ListHeartbeats(ctx)
+		if err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("API request failed")
-	}
-
-	return &apiResp.Result, nil
+		return MatchingIDs(heartbeats, func(existing Heartbeat) string { return existing.ID }, func(existing Heartbeat) bool {
+			return existing.Name == hb.Name
+		}), nil
+	})
 }
 
 // GetHeartbeat retrieves a heartbeat by ID
-func (c *Client) GetHeartbeat(id string) (*Heartbeat, error) {
-	respBody, err := c.Get(fmt.Sprintf("/v1/heartbeats/%s", id))
+func (c *Client) GetHeartbeat(ctx context.Context, id string) (*Heartbeat, error) {
+	respBody, err := c.Get(ctx, fmt.Sprintf("/v1/heartbeats/%s", id))
 	if err != nil {
 		return nil, err
 	}
@@ -69,8 +63,8 @@ func (c *Client) GetHeartbeat(id string) (*Heartbeat, error) {
 }
 
 // UpdateHeartbeat updates an existing heartbeat
-func (c *Client) UpdateHeartbeat(id string, hb *Heartbeat) (*Heartbeat, error) {
-	respBody, err := c.Patch(fmt.Sprintf("/v1/heartbeats/%s", id), hb)
+func (c *Client) UpdateHeartbeat(ctx context.Context, id string, hb *Heartbeat) (*Heartbeat, error) {
+	respBody, err := c.Patch(ctx, fmt.Sprintf("/v1/heartbeats/%s", id), hb)
 	if err != nil {
 		return nil, err
 	}
@@ -91,29 +85,12 @@ func (c *Client) UpdateHeartbeat(id string, hb *Heartbeat) (*Heartbeat, error) {
 }
 
 // DeleteHeartbeat deletes a heartbeat
-func (c *Client) DeleteHeartbeat(id string) error {
-	_, err := c.Delete(fmt.Sprintf("/v1/heartbeats/%s", id))
+func (c *Client) DeleteHeartbeat(ctx context.Context, id string) error {
+	_, err := c.Delete(ctx, fmt.Sprintf("/v1/heartbeats/%s", id))
 	return err
 }
 
 // ListHeartbeats retrieves all heartbeats
-func (c *Client) ListHeartbeats() ([]Heartbeat, error) {
-	respBody, err := c.Get("/v1/heartbeats")
-	if err != nil {
-		return nil, err
-	}
-
-	var apiResp APIListResponse[Heartbeat]
-	if err := json.Unmarshal(respBody, &apiResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	if !apiResp.Success {
-		if len(apiResp.Errors) > 0 {
-			return nil, fmt.Errorf("API error: %s", apiResp.Errors[0].Message)
-		}
-		return nil, fmt.Errorf("API request failed")
-	}
-
-	return apiResp.Result, nil
+func (c *Client) ListHeartbeats(ctx context.Context) ([]Heartbeat, error) {
+	return listCached[Heartbeat](ctx, c, "/v1/heartbeats")
 }
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+const (
+	// IdempotencyKeyHeader is sent with every create request so the API can
+	// recognise a retried request and return the object it already created.
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// createAttempts is the number of times a create is sent before giving up
+	createAttempts = 3
+)
+
+// createIdempotent POSTs body to path under a fresh idempotency key. Failures
+// that leave the outcome unknown (timeouts, dropped connections, 5xx) are
+// retried with the same key until ctx is done. If the outcome is still
+// unknown after the final attempt, find is used to list existing objects the
+// server may have created. They cannot be told apart from objects created by
+// someone else, so they are never adopted: their IDs are added to the error
+// so the user can import or delete them.
+func createIdempotent[T any](ctx context.Context, c *Client, path string, body interface{}, find func() ([]string, error)) (*T, error) {
+	key, err := uuid.GenerateUUID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate idempotency key: %w", err)
+	}
+	headers := map[string]string{IdempotencyKeyHeader: key}
+
+	var lastErr error
+	for attempt := 0; attempt < createAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, fmt.Errorf("%w (retry cancelled: %s)", lastErr, ctx.Err())
+			case <-time.After(time.Duration(attempt) * c.retryBackoff):
+			}
+		}
+
+		respBody, err := c.doRequestWithHeaders(ctx, http.MethodPost, path, body, headers)
+		if err == nil {
+			return parseAPIResponse[T](respBody)
+		}
+		if !isAmbiguous(err) {
+			return nil, err
+		}
+		lastErr = err
+	}
+
+	if find == nil {
+		return nil, lastErr
+	}
+
+	ids, err := find()
+	if err != nil {
+		return nil, fmt.Errorf("%w (the object may have been created; lookup failed: %s)", lastErr, err)
+	}
+	if len(ids) == 0 {
+		return nil, lastErr
+	}
+	return nil, fmt.Errorf("%w (the object may have been created anyway; existing objects with the same attributes: %s. Import the one created here or delete it before retrying)", lastErr, strings.Join(ids, ", "))
+}
+
+// MatchingIDs returns the IDs of the items that match accepts, in order. It
+// is used to look for existing objects that resemble one being created.
+func MatchingIDs[T any](items []T, id func(T) string, match func(T) bool) []string {
+	var ids []string
+	for _, item := range items {
+		if match(item) {
+			ids = append(ids, id(item))
+		}
+	}
+	return ids
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CheckIncident represents a period during which a check was down
+type CheckIncident struct {
+	ID              string   `json:"id"`
+	StartedAt       string   `json:"started_at"`
+	EndedAt         string   `json:"ended_at,omitempty"`
+	DurationSeconds int      `json:"duration_seconds"`
+	Regions         []string `json:"regions,omitempty"`
+	Reason          string   `json:"reason,omitempty"`
+}
+
+// HeartbeatIncident represents a period during which a heartbeat missed
+// its expected pings
+type HeartbeatIncident struct {
+	ID              string `json:"id"`
+	StartedAt       string `json:"started_at"`
+	EndedAt         string `json:"ended_at,omitempty"`
+	DurationSeconds int    `json:"duration_seconds"`
+	MissedPings     int    `json:"missed_pings"`
+}
+
+// ListCheckIncidents retrieves the incidents of a check that overlap the
+// window between since and until
+func (c *Client) ListCheckIncidents(ctx context.Context, checkID string, since, until time.Time) ([]CheckIncident, error) {
+	return listAll[CheckIncident](ctx, c, fmt.Sprintf("/v1/checks/%s/incidents?%s", checkID, timeWindowQuery(since, until)))
+}
+
+// ListHeartbeatIncidents retrieves the incidents of a heartbeat that
+// overlap the window between since and until
+func (c *Client) ListHeartbeatIncidents(ctx context.Context, heartbeatID string, since, until time.Time) ([]HeartbeatIncident, error) {
+	return listAll[HeartbeatIncident](ctx, c, fmt.Sprintf("/v1/heartbeats/%s/incidents?%s", heartbeatID, timeWindowQuery(since, until)))
+}
@@ -0,0 +1,42 @@
+package client
+
+import "context"
+
+// Integration represents an alert destination connected to the
+// organisation, such as a Slack channel or an on-call schedule. Its ID is
+// what the *_alerts attributes of checks and heartbeats refer to.
+type Integration struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Provider is the on-call service behind an on-call integration, e.g.
+	// PAGERDUTY
+	Provider string `json:"provider,omitempty"`
+}
+
+// ListSlackChannels retrieves the Slack channels alerts can be sent to
+func (c *Client) ListSlackChannels(ctx context.Context) ([]Integration, error) {
+	return listCached[Integration](ctx, c, "/v1/integrations/slack")
+}
+
+// ListDiscordChannels retrieves the Discord channels alerts can be sent to
+func (c *Client) ListDiscordChannels(ctx context.Context) ([]Integration, error) {
+	return listCached[Integration](ctx, c, "/v1/integrations/discord")
+}
+
+// ListMicrosoftTeamsChannels retrieves the Microsoft Teams channels alerts
+// can be sent to
+func (c *Client) ListMicrosoftTeamsChannels(ctx context.Context) ([]Integration, error) {
+	return listCached[Integration](ctx, c, "/v1/integrations/microsoft_teams")
+}
+
+// ListIncidentIOIntegrations retrieves the incident.io integrations alerts
+// can be sent to
+func (c *Client) ListIncidentIOIntegrations(ctx context.Context) ([]Integration, error) {
+	return listCached[Integration](ctx, c, "/v1/integrations/incident_io")
+}
+
+// ListOncallIntegrations retrieves the on-call schedules (Grafana,
+// PagerDuty, Opsgenie, Spike) alerts can be sent to
+func (c *Client) ListOncallIntegrations(ctx context.Context) ([]Integration, error) {
+	return listCached[Integration](ctx, c, "/v1/integrations/oncall")
+}
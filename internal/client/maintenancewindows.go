@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -18,30 +19,21 @@ type MaintenanceWindow struct {
 }
 
 // CreateMaintenanceWindow creates a new maintenance window
-func (c *Client) CreateMaintenanceWindow(mw *MaintenanceWindow) (*MaintenanceWindow, error) {
-	respBody, err := c.Post("/v1/maintenance-windows", mw)
-	if err != nil {
-		return nil, err
-	}
-
-	var apiResp APIResponse[MaintenanceWindow]
-	if err := json.Unmarshal(respBody, &apiResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	if !apiResp.Success {
-		if len(apiResp.Errors) > 0 {
-			return nil, fmt.Errorf("API error: %s", apiResp.Errors[0].Message)
+func (c *Client) CreateMaintenanceWindow(ctx context.Context, mw *MaintenanceWindow) (*MaintenanceWindow, error) {
+	return createIdempotent[MaintenanceWindow](ctx, c, "/v1/maintenance-windows", mw, func() ([]string, error) {
+		windows, err := c.ListMaintenanceWindows(ctx)
+		if err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("API request failed")
-	}
-
-	return &apiResp.Result, nil
+		return MatchingIDs(windows, func(existing MaintenanceWindow) string { return existing.ID }, func(existing MaintenanceWindow) bool {
+			return existing.Name == mw.Name && existing.StartDate == mw.StartDate
+		}), nil
+	})
 }
 
 // GetMaintenanceWindow retrieves a maintenance window by ID
-func (c *Client) GetMaintenanceWindow(id string) (*MaintenanceWindow, error) {
-	respBody, err := c.Get(fmt.Sprintf("/v1/maintenance-windows/%s", id))
+func (c *Client) GetMaintenanceWindow(ctx context.Context, id string) (*MaintenanceWindow, error) {
+	respBody, err := c.Get(ctx, fmt.Sprintf("/v1/maintenance-windows/%s", id))
 	if err != nil {
 		return nil, err
 	}
@@ -62,8 +54,8 @@ func (c *Client) GetMaintenanceWindow(id string) (*MaintenanceWindow, error) {
 }
 
 // UpdateMaintenanceWindow updates an existing maintenance window
-func (c *Client) UpdateMaintenanceWindow(id string, mw *MaintenanceWindow) (*MaintenanceWindow, error) {
-	respBody, err := c.Patch(fmt.Sprintf("/v1/maintenance-windows/%s", id), mw)
+func (c *Client) UpdateMaintenanceWindow(ctx context.Context, id string, mw *MaintenanceWindow) (*MaintenanceWindow, error) {
+	respBody, err := c.Patch(ctx, fmt.Sprintf("/v1/maintenance-windows/%s", id), mw)
 	if err != nil {
 		return nil, err
 	}
@@ -84,29 +76,12 @@ func (c *Client) UpdateMaintenanceWindow(id string, mw *MaintenanceWindow) (*Mai
 }
 
 // DeleteMaintenanceWindow deletes a maintenance window
-func (c *Client) DeleteMaintenanceWindow(id string) error {
-	_, err := c.Delete(fmt.Sprintf("/v1/maintenance-windows/%s", id))
+func (c *Client) DeleteMaintenanceWindow(ctx context.Context, id string) error {
+	_, err := c.Delete(ctx, fmt.Sprintf("/v1/maintenance-windows/%s", id))
 	return err
 }
 
 // ListMaintenanceWindows retrieves all maintenance windows
-func (c *Client) ListMaintenanceWindows() ([]MaintenanceWindow, error) {
-	respBody, err := c.Get("/v1/maintenance-windows")
-	if err != nil {
-		return nil, err
-	}
-
-	var apiResp APIListResponse[MaintenanceWindow]
-	if err := json.Unmarshal(respBody, &apiResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	if !apiResp.Success {
-		if len(apiResp.Errors) > 0 {
-			return nil, fmt.Errorf("API error: %s", apiResp.Errors[0].Message)
-		}
-		return nil, fmt.Errorf("API request failed")
-	}
-
-	return apiResp.Result, nil
+func (c *Client) ListMaintenanceWindows(ctx context.Context) ([]MaintenanceWindow, error) {
+	return listCached[MaintenanceWindow](ctx, c, "/v1/maintenance-windows")
 }
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// StatusPaused is the status of a paused check or heartbeat
+const StatusPaused = "PAUSED"
+
+// MonitorStatus is returned by the pause and resume endpoints
+type MonitorStatus struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// PauseCheck stops a check from running until it is resumed
+func (c *Client) PauseCheck(ctx context.Context, id string) (*MonitorStatus, error) {
+	return c.setMonitorState(ctx, "checks", id, "pause")
+}
+
+// ResumeCheck restarts a paused check
+func (c *Client) ResumeCheck(ctx context.Context, id string) (*MonitorStatus, error) {
+	return c.setMonitorState(ctx, "checks", id, "resume")
+}
+
+// PauseHeartbeat stops alerting on missed pings of a heartbeat until it is resumed
+func (c *Client) PauseHeartbeat(ctx context.Context, id string) (*MonitorStatus, error) {
+	return c.setMonitorState(ctx, "heartbeats", id, "pause")
+}
+
+// ResumeHeartbeat restarts a paused heartbeat
+func (c *Client) ResumeHeartbeat(ctx context.Context, id string) (*MonitorStatus, error) {
+	return c.setMonitorState(ctx, "heartbeats", id, "resume")
+}
+
+func (c *Client) setMonitorState(ctx context.Context, collection, id, operation string) (*MonitorStatus, error) {
+	respBody, err := c.Post(ctx, fmt.Sprintf("/v1/%s/%s/%s", collection, id, operation), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseAPIResponse[MonitorStatus](respBody)
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"math"
+
+	"golang.org/x/time/rate"
+)
+
+// newRateLimiter returns a token bucket limiter for the configured rate, or
+// nil when rate limiting is disabled. The bucket holds one second's worth of
+// tokens so short bursts are allowed without exceeding the sustained rate.
+func newRateLimiter(requestsPerSecond float64) *rate.Limiter {
+	if requestsPerSecond < 0 {
+		return nil
+	}
+	if requestsPerSecond == 0 {
+		requestsPerSecond = DefaultRequestsPerSecond
+	}
+
+	burst := int(math.Ceil(requestsPerSecond))
+	if burst < 1 {
+		burst = 1
+	}
+
+	return rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+}
+
+// newConcurrencySlots returns a semaphore channel sized to the configured
+// concurrency cap, or nil when the cap is disabled.
+func newConcurrencySlots(maxConcurrent int) chan struct{} {
+	if maxConcurrent < 0 {
+		return nil
+	}
+	if maxConcurrent == 0 {
+		maxConcurrent = DefaultMaxConcurrentRequests
+	}
+
+	return make(chan struct{}, maxConcurrent)
+}
+
+// acquire blocks until the request may be sent, taking a concurrency slot and
+// a rate limiter token. The returned function releases the slot and must be
+// called once the response has been consumed.
+func (c *Client) acquire(ctx context.Context) (func(), error) {
+	release := func() {}
+
+	if c.slots != nil {
+		select {
+		case c.slots <- struct{}{}:
+			release = func() { <-c.slots }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
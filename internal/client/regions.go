@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"sync"
+)
+
+// Region represents a probe region checks can run from
+type Region struct {
+	ID                    string `json:"id"`
+	Provider              string `json:"provider"`
+	Continent             string `json:"continent"`
+	SupportsBrowserChecks bool   `json:"supports_browser_checks"`
+}
+
+//go:embed regions.json
+var defaultRegionsJSON []byte
+
+var defaultRegions = sync.OnceValue(func() []Region {
+	var regions []Region
+	if err := json.Unmarshal(defaultRegionsJSON, &regions); err != nil {
+		panic("client: invalid regions.json: " + err.Error())
+	}
+	return regions
+})
+
+// ListRegions retrieves the probe regions checks can run from
+func (c *Client) ListRegions(ctx context.Context) ([]Region, error) {
+	return listCached[Region](ctx, c, "/v1/regions")
+}
+
+// DefaultRegions returns the probe regions built into the provider, for use
+// when the API cannot be reached
+func DefaultRegions() []Region {
+	return append([]Region(nil), defaultRegions()...)
+}
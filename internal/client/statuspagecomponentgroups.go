@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -14,30 +15,21 @@ type StatusPageComponentGroup struct {
 }
 
 // CreateStatusPageComponentGroup creates a new status page component group
-func (c *Client) CreateStatusPageComponentGroup(statusPageID string, group *StatusPageComponentGroup) (*StatusPageComponentGroup, error) {
-	respBody, err := c.Post(fmt.Sprintf("/v1/status_pages/%s/component_groups", statusPageID), group)
-	if err != nil {
-		return nil, err
-	}
-
-	var apiResp APIResponse[StatusPageComponentGroup]
-	if err := json.Unmarshal(respBody, &apiResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	if !apiResp.Success {
-		if len(apiResp.Errors) > 0 {
-			return nil, fmt.Errorf("API error: %s", apiResp.Errors[0].Message)
+func (c *Client) CreateStatusPageComponentGroup(ctx context.Context, statusPageID string, group *StatusPageComponentGroup) (*StatusPageComponentGroup, error) {
+	return createIdempotent[StatusPageComponentGroup](ctx, c, fmt.Sprintf("/v1/status_pages/%s/component_groups", statusPageID), group, func() ([]string, error) {
+		groups, err := c.ListStatusPageComponentGroups(ctx, statusPageID)
+		if err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("API request failed")
-	}
-
-	return &apiResp.Result, nil
+		return MatchingIDs(groups, func(existing StatusPageComponentGroup) string { return existing.ID }, func(existing StatusPageComponentGroup) bool {
+			return existing.Name == group.Name
+		}), nil
+	})
 }
 
 // GetStatusPageComponentGroup retrieves a status page component group by ID
-func (c *Client) GetStatusPageComponentGroup(statusPageID, groupID string) (*StatusPageComponentGroup, error) {
-	respBody, err := c.Get(fmt.Sprintf("/v1/status_pages/%s/component_groups/%s", statusPageID, groupID))
+func (c *Client) GetStatusPageComponentGroup(ctx context.Context, statusPageID, groupID string) (*StatusPageComponentGroup, error) {
+	respBody, err := c.Get(ctx, fmt.Sprintf("/v1/status_pages/%s/component_groups/%s", statusPageID, groupID))
 	if err != nil {
 		return nil, err
 	}
@@ -58,8 +50,8 @@ func (c *Client) GetStatusPageComponentGroup(statusPageID, groupID string) (*Sta
 }
 
 // UpdateStatusPageComponentGroup updates an existing status page component group
-func (c *Client) UpdateStatusPageComponentGroup(statusPageID, groupID string, group *StatusPageComponentGroup) (*StatusPageComponentGroup, error) {
-	respBody, err := c.Patch(fmt.Sprintf("/v1/status_pages/%s/component_groups/%s", statusPageID, groupID), group)
+func (c *Client) UpdateStatusPageComponentGroup(ctx context.Context, statusPageID, groupID string, group *StatusPageComponentGroup) (*StatusPageComponentGroup, error) {
+	respBody, err := c.Patch(ctx, fmt.Sprintf("/v1/status_pages/%s/component_groups/%s", statusPageID, groupID), group)
 	if err != nil {
 		return nil, err
 	}
@@ -80,14 +72,14 @@ func (c *Client) UpdateStatusPageComponentGroup(statusPageID, groupID string, gr
 }
 
 // DeleteStatusPageComponentGroup deletes a status page component group
-func (c *Client) DeleteStatusPageComponentGroup(statusPageID, groupID string) error {
-	_, err := c.Delete(fmt.Sprintf("/v1/status_pages/%s/component_groups/%s", statusPageID, groupID))
+func (c *Client) DeleteStatusPageComponentGroup(ctx context.Context, statusPageID, groupID string) error {
+	_, err := c.Delete(ctx, fmt.Sprintf("/v1/status_pages/%s/component_groups/%s", statusPageID, groupID))
 	return err
 }
 
 // ListStatusPageComponentGroups retrieves all component groups for a status page
-func (c *Client) ListStatusPageComponentGroups(statusPageID string) ([]StatusPageComponentGroup, error) {
-	respBody, err := c.Get(fmt.Sprintf("/v1/status_pages/%s/component_groups", statusPageID))
+func (c *Client) ListStatusPageComponentGroups(ctx context.Context, statusPageID string) ([]StatusPageComponentGroup, error) {
+	respBody, err := c.Get(ctx, fmt.Sprintf("/v1/status_pages/%s/component_groups", statusPageID))
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -16,30 +17,21 @@ type StatusPageComponent struct {
 }
 
 // CreateStatusPageComponent creates a new status page component
-func (c *Client) CreateStatusPageComponent(statusPageID string, comp *StatusPageComponent) (*StatusPageComponent, error) {
-	respBody, err := c.Post(fmt.Sprintf("/v1/status_pages/%s/components", statusPageID), comp)
-	if err != nil {
-		return nil, err
-	}
-
-	var apiResp APIResponse[StatusPageComponent]
-	if err := json.Unmarshal(respBody, &apiResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	if !apiResp.Success {
-		if len(apiResp.Errors) > 0 {
-			return nil, fmt.Errorf("API error: %s", apiResp.Errors[0].Message)
+func (c *Client) CreateStatusPageComponent(ctx context.Context, statusPageID string, comp *StatusPageComponent) (*StatusPageComponent, error) {
+	return createIdempotent[StatusPageComponent](ctx, c, fmt.Sprintf("/v1/status_pages/%s/components", statusPageID), comp, func() ([]string, error) {
+		components, err := c.ListStatusPageComponents(ctx, statusPageID)
+		if err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("API request failed")
-	}
-
-	return &apiResp.Result, nil
+		return MatchingIDs(components, func(existing StatusPageComponent) string { return existing.ID }, func(existing StatusPageComponent) bool {
+			return existing.Name == comp.Name
+		}), nil
+	})
 }
 
 // GetStatusPageComponent retrieves a status page component by ID
-func (c *Client) GetStatusPageComponent(statusPageID, componentID string) (*StatusPageComponent, error) {
-	respBody, err := c.Get(fmt.Sprintf("/v1/status_pages/%s/components/%s", statusPageID, componentID))
+func (c *Client) GetStatusPageComponent(ctx context.Context, statusPageID, componentID string) (*StatusPageComponent, error) {
+	respBody, err := c.Get(ctx, fmt.Sprintf("/v1/status_pages/%s/components/%s", statusPageID, componentID))
 	if err != nil {
 		return nil, err
 	}
@@ -60,8 +52,8 @@ func (c *Client) GetStatusPageComponent(statusPageID, componentID string) (*Stat
 }
 
 // UpdateStatusPageComponent updates an existing status page component
-func (c *Client) UpdateStatusPageComponent(statusPageID, componentID string, comp *StatusPageComponent) (*StatusPageComponent, error) {
-	respBody, err := c.Patch(fmt.Sprintf("/v1/status_pages/%s/components/%s", statusPageID, componentID), comp)
+func (c *Client) UpdateStatusPageComponent(ctx context.Context, statusPageID, componentID string, comp *StatusPageComponent) (*StatusPageComponent, error) {
+	respBody, err := c.Patch(ctx, fmt.Sprintf("/v1/status_pages/%s/components/%s", statusPageID, componentID), comp)
 	if err != nil {
 		return nil, err
 	}
@@ -82,14 +74,14 @@ func (c *Client) UpdateStatusPageComponent(statusPageID, componentID string, com
 }
 
 // DeleteStatusPageComponent deletes a status page component
-func (c *Client) DeleteStatusPageComponent(statusPageID, componentID string) error {
-	_, err := c.Delete(fmt.Sprintf("/v1/status_pages/%s/components/%s", statusPageID, componentID))
+func (c *Client) DeleteStatusPageComponent(ctx context.Context, statusPageID, componentID string) error {
+	_, err := c.Delete(ctx, fmt.Sprintf("/v1/status_pages/%s/components/%s", statusPageID, componentID))
 	return err
 }
 
 // ListStatusPageComponents retrieves all components for a status page
-func (c *Client) ListStatusPageComponents(statusPageID string) ([]StatusPageComponent, error) {
-	respBody, err := c.Get(fmt.Sprintf("/v1/status_pages/%s/components", statusPageID))
+func (c *Client) ListStatusPageComponents(ctx context.Context, statusPageID string) ([]StatusPageComponent, error) {
+	respBody, err := c.Get(ctx, fmt.Sprintf("/v1/status_pages/%s/components", statusPageID))
 	if err != nil {
 		return nil, err
 	}
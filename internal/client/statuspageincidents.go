@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -22,31 +23,32 @@ type StatusPageIncident struct {
 	Components        []StatusPageIncidentComponent `json:"components,omitempty"`
 }
 
-// CreateStatusPageIncident creates a new status page incident
-func (c *Client) CreateStatusPageIncident(statusPageID string, incident *StatusPageIncident) (*StatusPageIncident, error) {
-	respBody, err := c.Post(fmt.Sprintf("/v1/status_pages/%s/incidents", statusPageID), incident)
-	if err != nil {
-		return nil, err
-	}
-
-	var apiResp APIResponse[StatusPageIncident]
-	if err := json.Unmarshal(respBody, &apiResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
+// StatusPageIncidentUpdate represents an update posted to a status page incident
+type StatusPageIncidentUpdate struct {
+	ID                string                        `json:"id,omitempty"`
+	Status            string                        `json:"status"`
+	Description       string                        `json:"description"`
+	NotifySubscribers *bool                         `json:"notify_subscribers,omitempty"`
+	Components        []StatusPageIncidentComponent `json:"components,omitempty"`
+	CreatedAt         string                        `json:"created_at,omitempty"`
+}
 
-	if !apiResp.Success {
-		if len(apiResp.Errors) > 0 {
-			return nil, fmt.Errorf("API error: %s", apiResp.Errors[0].Message)
+// CreateStatusPageIncident creates a new status page incident
+func (c *Client) CreateStatusPageIncident(ctx context.Context, statusPageID string, incident *StatusPageIncident) (*StatusPageIncident, error) {
+	return createIdempotent[StatusPageIncident](ctx, c, fmt.Sprintf("/v1/status_pages/%s/incidents", statusPageID), incident, func() ([]string, error) {
+		incidents, err := c.ListStatusPageIncidents(ctx, statusPageID)
+		if err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("API request failed")
-	}
-
-	return &apiResp.Result, nil
+		return MatchingIDs(incidents, func(existing StatusPageIncident) string { return existing.ID }, func(existing StatusPageIncident) bool {
+			return existing.Title == incident.Title && existing.Description == incident.Description
+		}), nil
+	})
 }
 
 // GetStatusPageIncident retrieves a status page incident by ID
-func (c *Client) GetStatusPageIncident(statusPageID, incidentID string) (*StatusPageIncident, error) {
-	respBody, err := c.Get(fmt.Sprintf("/v1/status_pages/%s/incidents/%s", statusPageID, incidentID))
+func (c *Client) GetStatusPageIncident(ctx context.Context, statusPageID, incidentID string) (*StatusPageIncident, error) {
+	respBody, err := c.Get(ctx, fmt.Sprintf("/v1/status_pages/%s/incidents/%s", statusPageID, incidentID))
 	if err != nil {
 		return nil, err
 	}
@@ -67,8 +69,8 @@ func (c *Client) GetStatusPageIncident(statusPageID, incidentID string) (*Status
 }
 
 // UpdateStatusPageIncident updates an existing status page incident
-func (c *Client) UpdateStatusPageIncident(statusPageID, incidentID string, incident *StatusPageIncident) (*StatusPageIncident, error) {
-	respBody, err := c.Patch(fmt.Sprintf("/v1/status_pages/%s/incidents/%s", statusPageID, incidentID), incident)
+func (c *Client) UpdateStatusPageIncident(ctx context.Context, statusPageID, incidentID string, incident *StatusPageIncident) (*StatusPageIncident, error) {
+	respBody, err := c.Patch(ctx, fmt.Sprintf("/v1/status_pages/%s/incidents/%s", statusPageID, incidentID), incident)
 	if err != nil {
 		return nil, err
 	}
@@ -89,14 +91,14 @@ func (c *Client) UpdateStatusPageIncident(statusPageID, incidentID string, incid
 }
 
 // DeleteStatusPageIncident deletes a status page incident
-func (c *Client) DeleteStatusPageIncident(statusPageID, incidentID string) error {
-	_, err := c.Delete(fmt.Sprintf("/v1/status_pages/%s/incidents/%s", statusPageID, incidentID))
+func (c *Client) DeleteStatusPageIncident(ctx context.Context, statusPageID, incidentID string) error {
+	_, err := c.Delete(ctx, fmt.Sprintf("/v1/status_pages/%s/incidents/%s", statusPageID, incidentID))
 	return err
 }
 
 // ListStatusPageIncidents retrieves all incidents for a status page
-func (c *Client) ListStatusPageIncidents(statusPageID string) ([]StatusPageIncident, error) {
-	respBody, err := c.Get(fmt.Sprintf("/v1/status_pages/%s/incidents", statusPageID))
+func (c *Client) ListStatusPageIncidents(ctx context.Context, statusPageID string) ([]StatusPageIncident, error) {
+	respBody, err := c.Get(ctx, fmt.Sprintf("/v1/status_pages/%s/incidents", statusPageID))
 	if err != nil {
 		return nil, err
 	}
@@ -115,3 +117,8 @@ func (c *Client) ListStatusPageIncidents(statusPageID string) ([]StatusPageIncid
 
 	return apiResp.Result, nil
 }
+
+// CreateStatusPageIncidentUpdate appends an update to a status page incident
+func (c *Client) CreateStatusPageIncidentUpdate(ctx context.Context, statusPageID, incidentID string, update *StatusPageIncidentUpdate) (*StatusPageIncidentUpdate, error) {
+	return createIdempotent[StatusPageIncidentUpdate](ctx, c, fmt.Sprintf("/v1/status_pages/%s/incidents/%s/updates", statusPageID, incidentID), update, nil)
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -18,30 +19,21 @@ type StatusPage struct {
 }
 
 // CreateStatusPage creates a new status page
-func (c *Client) CreateStatusPage(sp *StatusPage) (*StatusPage, error) {
-	respBody, err := c.Post("/v1/status_pages", sp)
-	if err != nil {
-		return nil, err
-	}
-
-	var apiResp APIResponse[StatusPage]
-	if err := json.Unmarshal(respBody, &apiResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	if !apiResp.Success {
-		if len(apiResp.Errors) > 0 {
-			return nil, fmt.Errorf("API error: %s", apiResp.Errors[0].Message)
+func (c *Client) CreateStatusPage(ctx context.Context, sp *StatusPage) (*StatusPage, error) {
+	return createIdempotent[StatusPage](ctx, c, "/v1/status_pages", sp, func() ([]string, error) {
+		statusPages, err := c.ListStatusPages(ctx)
+		if err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("API request failed")
-	}
-
-	return &apiResp.Result, nil
+		return MatchingIDs(statusPages, func(existing StatusPage) string { return existing.ID }, func(existing StatusPage) bool {
+			return existing.Subdomain == sp.Subdomain
+		}), nil
+	})
 }
 
 // GetStatusPage retrieves a status page by ID
-func (c *Client) GetStatusPage(id string) (*StatusPage, error) {
-	respBody, err := c.Get(fmt.Sprintf("/v1/status_pages/%s", id))
+func (c *Client) GetStatusPage(ctx context.Context, id string) (*StatusPage, error) {
+	respBody, err := c.Get(ctx, fmt.Sprintf("/v1/status_pages/%s", id))
 	if err != nil {
 		return nil, err
 	}
@@ -62,8 +54,8 @@ func (c *Client) GetStatusPage(id string) (*StatusPage, error) {
 }
 
 // UpdateStatusPage updates an existing status page
-func (c *Client) UpdateStatusPage(id string, sp *StatusPage) (*StatusPage, error) {
-	respBody, err := c.Patch(fmt.Sprintf("/v1/status_pages/%s", id), sp)
+func (c *Client) UpdateStatusPage(ctx context.Context, id string, sp *StatusPage) (*StatusPage, error) {
+	respBody, err := c.Patch(ctx, fmt.Sprintf("/v1/status_pages/%s", id), sp)
 	if err != nil {
 		return nil, err
 	}
@@ -84,29 +76,12 @@ func (c *Client) UpdateStatusPage(id string, sp *StatusPage) (*StatusPage, error
 }
 
 // DeleteStatusPage deletes a status page
-func (c *Client) DeleteStatusPage(id string) error {
-	_, err := c.Delete(fmt.Sprintf("/v1/status_pages/%s", id))
+func (c *Client) DeleteStatusPage(ctx context.Context, id string) error {
+	_, err := c.Delete(ctx, fmt.Sprintf("/v1/status_pages/%s", id))
 	return err
 }
 
 // ListStatusPages retrieves all status pages
-func (c *Client) ListStatusPages() ([]StatusPage, error) {
-	respBody, err := c.Get("/v1/status_pages")
-	if err != nil {
-		return nil, err
-	}
-
-	var apiResp APIListResponse[StatusPage]
-	if err := json.Unmarshal(respBody, &apiResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	if !apiResp.Success {
-		if len(apiResp.Errors) > 0 {
-			return nil, fmt.Errorf("API error: %s", apiResp.Errors[0].Message)
-		}
-		return nil, fmt.Errorf("API request failed")
-	}
-
-	return apiResp.Result, nil
+func (c *Client) ListStatusPages(ctx context.Context) ([]StatusPage, error) {
+	return listCached[StatusPage](ctx, c, "/v1/status_pages")
 }
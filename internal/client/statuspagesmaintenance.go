@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -25,30 +26,21 @@ type StatusPageScheduledMaintenance struct {
 }
 
 // CreateStatusPageScheduledMaintenance creates a new scheduled maintenance
-func (c *Client) CreateStatusPageScheduledMaintenance(statusPageID string, sm *StatusPageScheduledMaintenance) (*StatusPageScheduledMaintenance, error) {
-	respBody, err := c.Post(fmt.Sprintf("/v1/status_pages/%s/scheduled_maintenance", statusPageID), sm)
-	if err != nil {
-		return nil, err
-	}
-
-	var apiResp APIResponse[StatusPageScheduledMaintenance]
-	if err := json.Unmarshal(respBody, &apiResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	if !apiResp.Success {
-		if len(apiResp.Errors) > 0 {
-			return nil, fmt.Errorf("API error: %s", apiResp.Errors[0].Message)
+func (c *Client) CreateStatusPageScheduledMaintenance(ctx context.Context, statusPageID string, sm *StatusPageScheduledMaintenance) (*StatusPageScheduledMaintenance, error) {
+	return createIdempotent[StatusPageScheduledMaintenance](ctx, c, fmt.Sprintf("/v1/status_pages/%s/scheduled_maintenance", statusPageID), sm, func() ([]string, error) {
+		maintenances, err := c.ListStatusPageScheduledMaintenances(ctx, statusPageID)
+		if err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("API request failed")
-	}
-
-	return &apiResp.Result, nil
+		return MatchingIDs(maintenances, func(existing StatusPageScheduledMaintenance) string { return existing.ID }, func(existing StatusPageScheduledMaintenance) bool {
+			return existing.Title == sm.Title && existing.StartDate == sm.StartDate
+		}), nil
+	})
 }
 
 // GetStatusPageScheduledMaintenance retrieves a scheduled maintenance by ID
-func (c *Client) GetStatusPageScheduledMaintenance(statusPageID, smID string) (*StatusPageScheduledMaintenance, error) {
-	respBody, err := c.Get(fmt.Sprintf("/v1/status_pages/%s/scheduled_maintenance/%s", statusPageID, smID))
+func (c *Client) GetStatusPageScheduledMaintenance(ctx context.Context, statusPageID, smID string) (*StatusPageScheduledMaintenance, error) {
+	respBody, err := c.Get(ctx, fmt.Sprintf("/v1/status_pages/%s/scheduled_maintenance/%s", statusPageID, smID))
 	if err != nil {
 		return nil, err
 	}
@@ -69,8 +61,8 @@ func (c *Client) GetStatusPageScheduledMaintenance(statusPageID, smID string) (*
 }
 
 // UpdateStatusPageScheduledMaintenance updates an existing scheduled maintenance
-func (c *Client) UpdateStatusPageScheduledMaintenance(statusPageID, smID string, sm *StatusPageScheduledMaintenance) (*StatusPageScheduledMaintenance, error) {
-	respBody, err := c.Patch(fmt.Sprintf("/v1/status_pages/%s/scheduled_maintenance/%s", statusPageID, smID), sm)
+func (c *Client) UpdateStatusPageScheduledMaintenance(ctx context.Context, statusPageID, smID string, sm *StatusPageScheduledMaintenance) (*StatusPageScheduledMaintenance, error) {
+	respBody, err := c.Patch(ctx, fmt.Sprintf("/v1/status_pages/%s/scheduled_maintenance/%s", statusPageID, smID), sm)
 	if err != nil {
 		return nil, err
 	}
@@ -91,14 +83,14 @@ func (c *Client) UpdateStatusPageScheduledMaintenance(statusPageID, smID string,
 }
 
 // DeleteStatusPageScheduledMaintenance deletes a scheduled maintenance
-func (c *Client) DeleteStatusPageScheduledMaintenance(statusPageID, smID string) error {
-	_, err := c.Delete(fmt.Sprintf("/v1/status_pages/%s/scheduled_maintenance/%s", statusPageID, smID))
+func (c *Client) DeleteStatusPageScheduledMaintenance(ctx context.Context, statusPageID, smID string) error {
+	_, err := c.Delete(ctx, fmt.Sprintf("/v1/status_pages/%s/scheduled_maintenance/%s", statusPageID, smID))
 	return err
 }
 
 // ListStatusPageScheduledMaintenances retrieves all scheduled maintenances for a status page
-func (c *Client) ListStatusPageScheduledMaintenances(statusPageID string) ([]StatusPageScheduledMaintenance, error) {
-	respBody, err := c.Get(fmt.Sprintf("/v1/status_pages/%s/scheduled_maintenance", statusPageID))
+func (c *Client) ListStatusPageScheduledMaintenances(ctx context.Context, statusPageID string) ([]StatusPageScheduledMaintenance, error) {
+	respBody, err := c.Get(ctx, fmt.Sprintf("/v1/status_pages/%s/scheduled_maintenance", statusPageID))
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -85,60 +86,129 @@ func parseAPIResponse[T any](respBody []byte) (*T, error) {
 	return &apiResp.Result, nil
 }
 
-func (c *Client) CreateDNSCheck(check *DNSCheck) (*DNSCheck, error) {
-	respBody, err := c.Post("/v1/checks/dns", check)
+func (c *Client) CreateDNSCheck(ctx context.Context, check *DNSCheck) (*DNSCheck, error) {
+	return createIdempotent[DNSCheck](ctx, c, "/v1/checks/dns", check, func() ([]string, error) {
+		checks, err := c.ListDNSChecks(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return MatchingIDs(checks, func(existing DNSCheck) string { return existing.ID }, func(existing DNSCheck) bool {
+			return existing.Name == check.Name && existing.DNSDomain == check.DNSDomain
+		}), nil
+	})
+}
+
+func (c *Client) GetDNSCheck(ctx context.Context, id string) (*DNSCheck, error) {
+	respBody, err := c.Get(ctx, fmt.Sprintf("/v1/checks/dns/%s", id))
 	if err != nil {
 		return nil, err
 	}
 	return parseAPIResponse[DNSCheck](respBody)
 }
 
-func (c *Client) GetDNSCheck(id string) (*DNSCheck, error) {
-	respBody, err := c.Get(fmt.Sprintf("/v1/checks/dns/%s", id))
+func (c *Client) UpdateDNSCheck(ctx context.Context, id string, check *DNSCheck) (*DNSCheck, error) {
+	respBody, err := c.Patch(ctx, fmt.Sprintf("/v1/checks/dns/%s", id), check)
 	if err != nil {
 		return nil, err
 	}
 	return parseAPIResponse[DNSCheck](respBody)
 }
 
-func (c *Client) UpdateDNSCheck(id string, check *DNSCheck) (*DNSCheck, error) {
-	respBody, err := c.Patch(fmt.Sprintf("/v1/checks/dns/%s", id), check)
+func (c *Client) DeleteDNSCheck(ctx context.Context, id string) error {
+	_, err := c.Delete(ctx, fmt.Sprintf("/v1/checks/dns/%s", id))
+	return err
+}
+
+// ListDNSChecks retrieves all DNS checks from the combined check listing
+func (c *Client) ListDNSChecks(ctx context.Context) ([]DNSCheck, error) {
+	checks, err := listCached[DNSCheck](ctx, c, "/v1/checks")
 	if err != nil {
 		return nil, err
 	}
-	return parseAPIResponse[DNSCheck](respBody)
+	return filterChecks(checks, "DNS", func(check DNSCheck) string { return check.CheckType }), nil
 }
 
-func (c *Client) DeleteDNSCheck(id string) error {
-	_, err := c.Delete(fmt.Sprintf("/v1/checks/dns/%s", id))
-	return err
+func (c *Client) CreateTCPCheck(ctx context.Context, check *TCPCheck) (*TCPCheck, error) {
+	return createIdempotent[TCPCheck](ctx, c, "/v1/checks/tcp", check, func() ([]string, error) {
+		checks, err := c.ListTCPChecks(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return MatchingIDs(checks, func(existing TCPCheck) string { return existing.ID }, func(existing TCPCheck) bool {
+			return existing.Name == check.Name && existing.TCPHostname == check.TCPHostname && existing.TCPPort == check.TCPPort
+		}), nil
+	})
 }
 
-func (c *Client) CreateTCPCheck(check *TCPCheck) (*TCPCheck, error) {
-	respBody, err := c.Post("/v1/checks/tcp", check)
+func (c *Client) GetTCPCheck(ctx context.Context, id string) (*TCPCheck, error) {
+	respBody, err := c.Get(ctx, fmt.Sprintf("/v1/checks/tcp/%s", id))
 	if err != nil {
 		return nil, err
 	}
 	return parseAPIResponse[TCPCheck](respBody)
 }
 
-func (c *Client) GetTCPCheck(id string) (*TCPCheck, error) {
-	respBody, err := c.Get(fmt.Sprintf("/v1/checks/tcp/%s", id))
+func (c *Client) UpdateTCPCheck(ctx context.Context, id string, check *TCPCheck) (*TCPCheck, error) {
+	respBody, err := c.Patch(ctx, fmt.Sprintf("/v1/checks/tcp/%s", id), check)
 	if err != nil {
 		return nil, err
 	}
 	return parseAPIResponse[TCPCheck](respBody)
 }
 
-func (c *Client) UpdateTCPCheck(id string, check *TCPCheck) (*TCPCheck, error) {
-	respBody, err := c.Patch(fmt.Sprintf("/v1/checks/tcp/%s", id), check)
+func (c *Client) DeleteTCPCheck(ctx context.Context, id string) error {
+	_, err := c.Delete(ctx, fmt.Sprintf("/v1/checks/tcp/%s", id))
+	return err
+}
+
+// ListTCPChecks retrieves all TCP checks from the combined check listing
+func (c *Client) ListTCPChecks(ctx context.Context) ([]TCPCheck, error) {
+	checks, err := listCached[TCPCheck](ctx, c, "/v1/checks")
 	if err != nil {
 		return nil, err
 	}
-	return parseAPIResponse[TCPCheck](respBody)
+	return filterChecks(checks, "TCP", func(check TCPCheck) string { return check.CheckType }), nil
 }
 
-func (c *Client) DeleteTCPCheck(id string) error {
-	_, err := c.Delete(fmt.Sprintf("/v1/checks/tcp/%s", id))
-	return err
+func filterChecks[T any](checks []T, checkType string, typeOf func(T) string) []T {
+	result := make([]T, 0, len(checks))
+	for _, check := range checks {
+		if typeOf(check) == checkType {
+			result = append(result, check)
+		}
+	}
+	return result
+}
+
+// AnyCheck is an entry of the combined check listing decoded according to
+// its check_type. The fields shared by every kind of check are always
+// decoded into the embedded Check, whose HTTP fields stay empty for DNS and
+// TCP checks. DNS or TCP is additionally set for checks of that kind.
+type AnyCheck struct {
+	Check
+	DNS *DNSCheck
+	TCP *TCPCheck
+}
+
+func (a *AnyCheck) UnmarshalJSON(data []byte) error {
+	var check Check
+	if err := json.Unmarshal(data, &check); err != nil {
+		return err
+	}
+	*a = AnyCheck{Check: check}
+
+	switch check.CheckType {
+	case "DNS":
+		a.DNS = &DNSCheck{}
+		return json.Unmarshal(data, a.DNS)
+	case "TCP":
+		a.TCP = &TCPCheck{}
+		return json.Unmarshal(data, a.TCP)
+	}
+	return nil
+}
+
+// ListAllChecks retrieves every check of every kind
+func (c *Client) ListAllChecks(ctx context.Context) ([]AnyCheck, error) {
+	return listCached[AnyCheck](ctx, c, "/v1/checks")
 }
@@ -1,9 +1,6 @@
 package client
 
-import (
-	"encoding/json"
-	"fmt"
-)
+import "context"
 
 // User represents a user in the organisation
 type User struct {
@@ -16,23 +13,6 @@ type User struct {
 }
 
 // ListUsers retrieves all users in the organisation
-func (c *Client) ListUsers() ([]User, error) {
-	respBody, err := c.Get("/v1/users")
-	if err != nil {
-		return nil, err
-	}
-
-	var apiResp APIListResponse[User]
-	if err := json.Unmarshal(respBody, &apiResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	if !apiResp.Success {
-		if len(apiResp.Errors) > 0 {
-			return nil, fmt.Errorf("API error: %s", apiResp.Errors[0].Message)
-		}
-		return nil, fmt.Errorf("API request failed")
-	}
-
-	return apiResp.Result, nil
+func (c *Client) ListUsers(ctx context.Context) ([]User, error) {
+	return listCached[User](ctx, c, "/v1/users")
 }
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -17,30 +18,21 @@ type Webhook struct {
 }
 
 // CreateWebhook creates a new webhook
-func (c *Client) CreateWebhook(wh *Webhook) (*Webhook, error) {
-	respBody, err := c.Post("/v1/webhooks", wh)
-	if err != nil {
-		return nil, err
-	}
-
-	var apiResp APIResponse[Webhook]
-	if err := json.Unmarshal(respBody, &apiResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	if !apiResp.Success {
-		if len(apiResp.Errors) > 0 {
-			return nil, fmt.Errorf("API error: %s", apiResp.Errors[0].Message)
+func (c *Client) CreateWebhook(ctx context.Context, wh *Webhook) (*Webhook, error) {
+	return createIdempotent[Webhook](ctx, c, "/v1/webhooks", wh, func() ([]string, error) {
+		webhooks, err := c.ListWebhooks(ctx)
+		if err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("API request failed")
-	}
-
-	return &apiResp.Result, nil
+		return MatchingIDs(webhooks, func(existing Webhook) string { return existing.ID }, func(existing Webhook) bool {
+			return existing.URL == wh.URL && existing.Description == wh.Description
+		}), nil
+	})
 }
 
 // GetWebhook retrieves a webhook by ID
-func (c *Client) GetWebhook(id string) (*Webhook, error) {
-	respBody, err := c.Get(fmt.Sprintf("/v1/webhooks/%s", id))
+func (c *Client) GetWebhook(ctx context.Context, id string) (*Webhook, error) {
+	respBody, err := c.Get(ctx, fmt.Sprintf("/v1/webhooks/%s", id))
 	if err != nil {
 		return nil, err
 	}
@@ -61,8 +53,8 @@ func (c *Client) GetWebhook(id string) (*Webhook, error) {
 }
 
 // UpdateWebhook updates an existing webhook
-func (c *Client) UpdateWebhook(id string, wh *Webhook) (*Webhook, error) {
-	respBody, err := c.Patch(fmt.Sprintf("/v1/webhooks/%s", id), wh)
+func (c *Client) UpdateWebhook(ctx context.Context, id string, wh *Webhook) (*Webhook, error) {
+	respBody, err := c.Patch(ctx, fmt.Sprintf("/v1/webhooks/%s", id), wh)
 	if err != nil {
 		return nil, err
 	}
@@ -83,29 +75,12 @@ func (c *Client) UpdateWebhook(id string, wh *Webhook) (*Webhook, error) {
 }
 
 // DeleteWebhook deletes a webhook
-func (c *Client) DeleteWebhook(id string) error {
-	_, err := c.Delete(fmt.Sprintf("/v1/webhooks/%s", id))
+func (c *Client) DeleteWebhook(ctx context.Context, id string) error {
+	_, err := c.Delete(ctx, fmt.Sprintf("/v1/webhooks/%s", id))
 	return err
 }
 
 // ListWebhooks retrieves all webhooks
-func (c *Client) ListWebhooks() ([]Webhook, error) {
-	respBody, err := c.Get("/v1/webhooks")
-	if err != nil {
-		return nil, err
-	}
-
-	var apiResp APIListResponse[Webhook]
-	if err := json.Unmarshal(respBody, &apiResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	if !apiResp.Success {
-		if len(apiResp.Errors) > 0 {
-			return nil, fmt.Errorf("API error: %s", apiResp.Errors[0].Message)
-		}
-		return nil, fmt.Errorf("API request failed")
-	}
-
-	return apiResp.Result, nil
+func (c *Client) ListWebhooks(ctx context.Context) ([]Webhook, error) {
+	return listCached[Webhook](ctx, c, "/v1/webhooks")
 }
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+var _ datasource.DataSource = &AccountDataSource{}
+var _ datasource.DataSourceWithConfigure = &AccountDataSource{}
+
+func NewAccountDataSource() datasource.DataSource {
+	return &AccountDataSource{}
+}
+
+// AccountDataSource defines the data source implementation.
+type AccountDataSource struct {
+	client *client.Client
+}
+
+// AccountDataSourceModel describes the data source data model.
+type AccountDataSourceModel struct {
+	OrganisationName types.String       `tfsdk:"organisation_name"`
+	Plan             types.String       `tfsdk:"plan"`
+	Quotas           accountQuotasModel `tfsdk:"quotas"`
+	Usage            accountUsageModel  `tfsdk:"usage"`
+}
+
+type accountQuotasModel struct {
+	Checks          types.Int64 `tfsdk:"checks"`
+	BrowserChecks   types.Int64 `tfsdk:"browser_checks"`
+	Heartbeats      types.Int64 `tfsdk:"heartbeats"`
+	StatusPages     types.Int64 `tfsdk:"status_pages"`
+	MinTestInterval types.Int64 `tfsdk:"min_test_interval"`
+}
+
+type accountUsageModel struct {
+	Checks        types.Int64 `tfsdk:"checks"`
+	BrowserChecks types.Int64 `tfsdk:"browser_checks"`
+	Heartbeats    types.Int64 `tfsdk:"heartbeats"`
+	StatusPages   types.Int64 `tfsdk:"status_pages"`
+}
+
+func (d *AccountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+func (d *AccountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the organisation the API key belongs to, the limits of its plan and how much of them is in use.",
+		Attributes: map[string]schema.Attribute{
+			"organisation_name": schema.StringAttribute{
+				Description: "Name of the organisation",
+				Computed:    true,
+			},
+			"plan": schema.StringAttribute{
+				Description: "Name of the organisation's plan",
+				Computed:    true,
+			},
+			"quotas": schema.SingleNestedAttribute{
+				Description: "Limits of the plan. Null counts are not limited by the plan.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"checks":            schema.Int64Attribute{Description: "Maximum number of checks, including browser checks", Computed: true},
+					"browser_checks":    schema.Int64Attribute{Description: "Maximum number of browser checks", Computed: true},
+					"heartbeats":        schema.Int64Attribute{Description: "Maximum number of heartbeats", Computed: true},
+					"status_pages":      schema.Int64Attribute{Description: "Maximum number of status pages", Computed: true},
+					"min_test_interval": schema.Int64Attribute{Description: "Shortest test_interval checks may use, in seconds", Computed: true},
+				},
+			},
+			"usage": schema.SingleNestedAttribute{
+				Description: "Number of objects counted against the quotas",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"checks":         schema.Int64Attribute{Description: "Number of checks, including browser checks", Computed: true},
+					"browser_checks": schema.Int64Attribute{Description: "Number of browser checks", Computed: true},
+					"heartbeats":     schema.Int64Attribute{Description: "Number of heartbeats", Computed: true},
+					"status_pages":   schema.Int64Attribute{Description: "Number of status pages", Computed: true},
+				},
+			},
+		},
+	}
+}
+
+func (d *AccountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	account, err := d.client.GetAccount(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read account, got error: %s", err))
+		return
+	}
+
+	data := AccountDataSourceModel{
+		OrganisationName: types.StringValue(account.OrganisationName),
+		Plan:             types.StringValue(account.Plan),
+		Quotas: accountQuotasModel{
+			Checks:          optionalInt64Value(account.Quotas.Checks),
+			BrowserChecks:   optionalInt64Value(account.Quotas.BrowserChecks),
+			Heartbeats:      optionalInt64Value(account.Quotas.Heartbeats),
+			StatusPages:     optionalInt64Value(account.Quotas.StatusPages),
+			MinTestInterval: optionalInt64Value(account.Quotas.MinTestInterval),
+		},
+		Usage: accountUsageModel{
+			Checks:        types.Int64Value(int64(account.Usage.Checks)),
+			BrowserChecks: types.Int64Value(int64(account.Usage.BrowserChecks)),
+			Heartbeats:    types.Int64Value(int64(account.Usage.Heartbeats)),
+			StatusPages:   types.Int64Value(int64(account.Usage.StatusPages)),
+		},
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+// adoptExistingAttribute returns the schema for the opt-in adopt_existing
+// attribute shared by the check and heartbeat resources.
+func adoptExistingAttribute(object, matchedOn string) schema.BoolAttribute {
+	description := fmt.Sprintf("When true, creating this resource takes over an existing %s with the same %s instead of creating a duplicate. The existing %s is updated to match this configuration and a warning is shown. Creation fails if more than one %s matches. Has no effect after the resource has been created.", object, matchedOn, object, object)
+	return schema.BoolAttribute{
+		Optional:            true,
+		Description:         description,
+		MarkdownDescription: description,
+	}
+}
+
+// findAdoptionCandidate returns the ID of the single item that match accepts,
+// or "" when none does. When several items match, an error diagnostic listing
+// them is added so the user can import the right one explicitly.
+func findAdoptionCandidate[T any](items []T, object string, id func(T) string, match func(T) bool, diags *diag.Diagnostics) string {
+	ids := client.MatchingIDs(items, id, match)
+
+	switch len(ids) {
+	case 0:
+		return ""
+	case 1:
+		return ids[0]
+	default:
+		diags.AddError(
+			"Ambiguous Existing Object",
+			fmt.Sprintf("adopt_existing is set but %d existing %ss match this configuration: %s. Import the intended one instead.", len(ids), object, strings.Join(ids, ", ")),
+		)
+		return ""
+	}
+}
+
+// addAdoptedWarning tells the user that Create took over an existing object
+func addAdoptedWarning(diags *diag.Diagnostics, object, id string) {
+	diags.AddWarning(
+		"Adopted Existing Object",
+		fmt.Sprintf("An existing %s with ID %s matched this configuration and was adopted instead of creating a new one. It has been updated to match the configuration.", object, id),
+	)
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/provider/resource_check"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &apiLookupDataSource[client.Check, checkDataSourceModel]{}
+
+// checkDataSourceModel is the check resource model without its
+// resource-only settings
+type checkDataSourceModel struct {
+	resource_check.CheckModel
+	monitorStatusModel
+	LastQueued types.String `tfsdk:"last_queued"`
+}
+
+// listUptimeAndBrowserChecks lists the checks managed by onlineornot_check,
+// leaving out the DNS and TCP checks the API returns alongside them
+func listUptimeAndBrowserChecks(c *client.Client, ctx context.Context) ([]client.Check, error) {
+	checks, err := c.ListAllChecks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]client.Check, 0, len(checks))
+	for _, check := range checks {
+		if check.DNS == nil && check.TCP == nil {
+			result = append(result, check.Check)
+		}
+	}
+	return result, nil
+}
+
+func NewCheckDataSource() datasource.DataSource {
+	checks := &CheckResource{}
+
+	return &apiLookupDataSource[client.Check, checkDataSourceModel]{
+		typeName:    "check",
+		description: "Looks up a single uptime or browser check by `id` or `name`. DNS and TCP checks are not matched.",
+		resource:    NewCheckResource,
+		exclude:     []string{"adopt_existing", "on_destroy"},
+		extra: map[string]schema.Attribute{
+			"paused": pausedDataSourceAttribute("check"),
+		},
+		list: listUptimeAndBrowserChecks,
+		lookup: objectLookup[client.Check]{
+			object:   "check",
+			id:       func(check client.Check) string { return check.ID },
+			describe: func(check client.Check) string { return fmt.Sprintf("%s, %s", check.Name, check.URL) },
+			fields: map[string]func(client.Check) string{
+				"id":   func(check client.Check) string { return check.ID },
+				"name": func(check client.Check) string { return check.Name },
+			},
+		},
+		model: func(ctx context.Context, check client.Check, diags *diag.Diagnostics) checkDataSourceModel {
+			var data checkResourceModel
+			checks.populateModelFromAPI(ctx, &data, &check, diags)
+			return checkDataSourceModel{
+				CheckModel:         data.CheckModel,
+				monitorStatusModel: data.monitorStatusModel,
+				LastQueued:         data.LastQueued,
+			}
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+var _ datasource.DataSourceWithConfigure = &monitorIncidentsDataSource[client.CheckIncident, checkIncidentModel]{}
+
+type checkIncidentModel struct {
+	monitorIncidentModel
+	Regions types.List   `tfsdk:"regions"`
+	Reason  types.String `tfsdk:"reason"`
+}
+
+func NewCheckIncidentsDataSource() datasource.DataSource {
+	return &monitorIncidentsDataSource[client.CheckIncident, checkIncidentModel]{
+		now:         time.Now,
+		monitor:     "check",
+		description: "Fetches the downtime history of a check over a time window, for example for post-incident reviews.",
+		attributes: map[string]schema.Attribute{
+			"regions": schema.ListAttribute{
+				Description: "Test regions that reported the check as down",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"reason": schema.StringAttribute{
+				Description: "Why the check failed, e.g. the HTTP status or the failed assertion",
+				Computed:    true,
+			},
+		},
+		list: (*client.Client).ListCheckIncidents,
+		model: func(ctx context.Context, incident client.CheckIncident, diags *diag.Diagnostics) checkIncidentModel {
+			return checkIncidentModel{
+				monitorIncidentModel: newMonitorIncidentModel(incident.ID, incident.StartedAt, incident.EndedAt, incident.DurationSeconds),
+				Regions:              stringListValue(ctx, incident.Regions, diags),
+				Reason:               optionalStringValue(incident.Reason),
+			}
+		},
+	}
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+var _ list.ListResourceWithConfigure = &apiListResource[client.Check, checkListConfigModel]{}
+var _ list.ListResourceWithConfigure = &apiListResource[client.DNSCheck, dnsCheckListConfigModel]{}
+var _ list.ListResourceWithConfigure = &apiListResource[client.TCPCheck, checkListConfigModel]{}
+
+// checkListConfigModel holds the filters of the check list blocks
+type checkListConfigModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Status     types.String `tfsdk:"status"`
+}
+
+// dnsCheckListConfigModel adds a record type filter for DNS checks
+type dnsCheckListConfigModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Status     types.String `tfsdk:"status"`
+	RecordType types.String `tfsdk:"record_type"`
+}
+
+func checkListSchema(object string) listschema.Schema {
+	return listschema.Schema{
+		Description: "Lists " + object + "s so they can be imported with `terraform query`.",
+		Attributes: map[string]listschema.Attribute{
+			"name_prefix": namePrefixAttribute(object),
+			"status":      statusAttribute(object),
+		},
+	}
+}
+
+func NewUptimeCheckListResource() list.ListResource {
+	return newTypedCheckListResource("uptime_check", "uptime", "uptime check")
+}
+
+func NewBrowserCheckListResource() list.ListResource {
+	return newTypedCheckListResource("browser_check", "browser", "browser check")
+}
+
+// newTypedCheckListResource lists the checks managed by the CheckResource
+// variant registered under typeName.
+func newTypedCheckListResource(typeName, endpointKind, object string) list.ListResource {
+	checks := &CheckResource{endpointKind: endpointKind}
+
+	return &apiListResource[client.Check, checkListConfigModel]{
+		typeName: typeName,
+		object:   object,
+		schema:   checkListSchema(object),
+		list: func(c *client.Client, ctx context.Context) ([]client.Check, error) {
+			return listChecksOfKind(ctx, c, endpointKind)
+		},
+		match: func(config checkListConfigModel, check client.Check) bool {
+			return matchesPrefix(config.NamePrefix, check.Name) && matchesFold(config.Status, check.Status)
+		},
+		id:          func(check client.Check) string { return check.ID },
+		displayName: func(check client.Check) string { return check.Name },
+		model: func(ctx context.Context, check client.Check, diags *diag.Diagnostics) any {
+			var data checkResourceModel
+			checks.populateModelFromAPI(ctx, &data, &check, diags)
+			return data
+		},
+	}
+}
+
+func NewDNSCheckListResource() list.ListResource {
+	s := checkListSchema("DNS check")
+	s.Attributes["record_type"] = listschema.StringAttribute{
+		Optional:    true,
+		Description: "Only return DNS checks for this record type, e.g. `A` or `MX`. Case-insensitive.",
+	}
+
+	return &apiListResource[client.DNSCheck, dnsCheckListConfigModel]{
+		typeName: "dns_check",
+		object:   "DNS check",
+		schema:   s,
+		list:     (*client.Client).ListDNSChecks,
+		match: func(config dnsCheckListConfigModel, check client.DNSCheck) bool {
+			return matchesPrefix(config.NamePrefix, check.Name) && matchesFold(config.Status, check.Status) &&
+				matchesFold(config.RecordType, check.DNSRecordType)
+		},
+		id:          func(check client.DNSCheck) string { return check.ID },
+		displayName: func(check client.DNSCheck) string { return check.Name },
+		model: func(ctx context.Context, check client.DNSCheck, diags *diag.Diagnostics) any {
+			var data DNSCheckModel
+			populateDNSModel(ctx, &data, &check, diags)
+			return data
+		},
+	}
+}
+
+func NewTCPCheckListResource() list.ListResource {
+	return &apiListResource[client.TCPCheck, checkListConfigModel]{
+		typeName: "tcp_check",
+		object:   "TCP check",
+		schema:   checkListSchema("TCP check"),
+		list:     (*client.Client).ListTCPChecks,
+		match: func(config checkListConfigModel, check client.TCPCheck) bool {
+			return matchesPrefix(config.NamePrefix, check.Name) && matchesFold(config.Status, check.Status)
+		},
+		id:          func(check client.TCPCheck) string { return check.ID },
+		displayName: func(check client.TCPCheck) string { return check.Name },
+		model: func(ctx context.Context, check client.TCPCheck, diags *diag.Diagnostics) any {
+			var data TCPCheckModel
+			populateTCPModel(ctx, &data, &check, diags)
+			return data
+		},
+	}
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -19,6 +20,10 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CheckResource{}
 var _ resource.ResourceWithImportState = &CheckResource{}
+var _ resource.ResourceWithIdentity = &CheckResource{}
+var _ resource.ResourceWithModifyPlan = &CheckResource{}
+
+const checkSchemaVersion = 0
 
 func NewCheckResource() resource.Resource {
 	return &CheckResource{}
@@ -40,6 +45,16 @@ func NewBrowserCheckResource() resource.Resource {
 	}
 }
 
+// checkResourceModel extends the generated check model with attributes that
+// are managed by the provider rather than the API.
+type checkResourceModel struct {
+	resource_check.CheckModel
+	monitorStatusModel
+	LastQueued    types.String `tfsdk:"last_queued"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
+	OnDestroy     types.String `tfsdk:"on_destroy"`
+}
+
 // CheckResource defines the resource implementation.
 type CheckResource struct {
 	client          *client.Client
@@ -67,6 +82,28 @@ func (r *CheckResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			resp.Schema.Attributes["type"] = typeAttr
 		}
 	}
+
+	// Basic auth passwords and request headers often carry credentials, so
+	// keep them out of plan output here and in every data source and list
+	// result built from this schema
+	if passwordAttr, ok := resp.Schema.Attributes["auth_password"].(schema.StringAttribute); ok {
+		passwordAttr.Sensitive = true
+		resp.Schema.Attributes["auth_password"] = passwordAttr
+	}
+	if headersAttr, ok := resp.Schema.Attributes["headers"].(schema.MapAttribute); ok {
+		headersAttr.Sensitive = true
+		resp.Schema.Attributes["headers"] = headersAttr
+	}
+
+	resp.Schema.Attributes["adopt_existing"] = adoptExistingAttribute("check", "name and URL")
+	resp.Schema.Attributes["on_destroy"] = onDestroyAttribute("check")
+	addMonitorStatusAttributes(resp.Schema.Attributes, "check")
+	resp.Schema.Attributes["last_queued"] = lastQueuedAttribute()
+	resp.Schema.Version = checkSchemaVersion
+}
+
+func (r *CheckResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Check")
 }
 
 func (r *CheckResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -88,8 +125,19 @@ func (r *CheckResource) Configure(ctx context.Context, req resource.ConfigureReq
 	r.client = c
 }
 
+func (r *CheckResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var checkType types.String
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("type"), &checkType)...)
+	} else {
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &checkType)...)
+	}
+	validateTestRegions(ctx, r.client, req, resp)
+	warnOnPlanLimits(ctx, r.client, req, resp, checkType.ValueString() == "BROWSER_CHECK")
+}
+
 func (r *CheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data resource_check.CheckModel
+	var data checkResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -160,25 +208,97 @@ func (r *CheckResource) Create(ctx context.Context, req resource.CreateRequest,
 		data.MicrosoftTeamsAlerts.ElementsAs(ctx, &check.MicrosoftTeamsAlerts, false)
 	}
 
+	// Take over a matching existing check instead of creating a duplicate
+	if data.AdoptExisting.ValueBool() {
+		existingID := r.findExistingCheck(ctx, check, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if existingID != "" {
+			adopted, err := r.client.UpdateTypedCheck(ctx, r.endpointKind, existingID, check)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to adopt existing check %s, got error: %s", existingID, err))
+				return
+			}
+			addAdoptedWarning(&resp.Diagnostics, "check", existingID)
+
+			adopted.Status = applyCheckPaused(ctx, r.client, data.Paused, adopted.ID, adopted.Status, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			r.populateModelFromAPI(ctx, &data, adopted, &resp.Diagnostics)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
+			return
+		}
+	}
+
 	// Create the check
-	created, err := r.client.CreateTypedCheck(r.endpointKind, check)
+	created, err := r.client.CreateTypedCheck(ctx, r.endpointKind, check)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create check, got error: %s", err))
 		return
 	}
 
+	created.Status = applyCheckPaused(ctx, r.client, data.Paused, created.ID, created.Status, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Populate state from the API response (includes computed defaults)
 	r.populateModelFromAPI(ctx, &data, created, &resp.Diagnostics)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
+}
+
+// findExistingCheck returns the ID of an existing check of this resource's
+// kind with the same name and URL, or "" when there is none.
+func (r *CheckResource) findExistingCheck(ctx context.Context, check *client.Check, diags *diag.Diagnostics) string {
+	checks, err := r.listChecks(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list checks to adopt, got error: %s", err))
+		return ""
+	}
+
+	return findAdoptionCandidate(checks, "check",
+		func(c client.Check) string { return c.ID },
+		func(c client.Check) bool { return c.Name == check.Name && c.URL == check.URL },
+		diags,
+	)
 }
 
-// populateModelFromAPI updates a CheckModel with values from the API response
-func (r *CheckResource) populateModelFromAPI(ctx context.Context, data *resource_check.CheckModel, check *client.Check, diags *diag.Diagnostics) {
+// listChecks returns the checks this resource can manage
+func (r *CheckResource) listChecks(ctx context.Context) ([]client.Check, error) {
+	return listChecksOfKind(ctx, r.client, r.endpointKind)
+}
+
+// listChecksOfKind returns all checks whose check_type matches endpointKind,
+// or every check when endpointKind is empty.
+func listChecksOfKind(ctx context.Context, c *client.Client, endpointKind string) ([]client.Check, error) {
+	checks, err := c.ListChecks(ctx)
+	if err != nil || endpointKind == "" {
+		return checks, err
+	}
+
+	kind := strings.ToUpper(endpointKind)
+	result := make([]client.Check, 0, len(checks))
+	for _, check := range checks {
+		if check.CheckType == kind {
+			result = append(result, check)
+		}
+	}
+	return result, nil
+}
+
+// populateModelFromAPI updates a check model with values from the API response
+func (r *CheckResource) populateModelFromAPI(ctx context.Context, data *checkResourceModel, check *client.Check, diags *diag.Diagnostics) {
 	data.Id = types.StringValue(check.ID)
 	data.Name = types.StringValue(check.Name)
 	data.Url = types.StringValue(check.URL)
+	data.setStatus(check.Status)
+	data.LastQueued = optionalStringValue(check.LastQueued)
 
 	// String fields with defaults
 	if check.Method != "" {
@@ -394,7 +514,7 @@ func (r *CheckResource) populateModelFromAPI(ctx context.Context, data *resource
 }
 
 func (r *CheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data resource_check.CheckModel
+	var data checkResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -404,7 +524,7 @@ func (r *CheckResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Get check from API
-	check, err := r.client.GetTypedCheck(r.endpointKind, data.Id.ValueString())
+	check, err := r.client.GetTypedCheck(ctx, r.endpointKind, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read check, got error: %s", err))
 		return
@@ -415,11 +535,12 @@ func (r *CheckResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *CheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data resource_check.CheckModel
-	var state resource_check.CheckModel
+	var data checkResourceModel
+	var state checkResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -495,21 +616,29 @@ func (r *CheckResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Update the check using the ID from state
-	updated, err := r.client.UpdateTypedCheck(r.endpointKind, checkID, check)
+	updated, err := r.client.UpdateTypedCheck(ctx, r.endpointKind, checkID, check)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update check, got error: %s", err))
 		return
 	}
 
+	updated.Status = applyCheckPaused(ctx, r.client, data.Paused, updated.ID, updated.Status, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Populate state from the API response
+	plannedStatus := data.Status
 	r.populateModelFromAPI(ctx, &data, updated, &resp.Diagnostics)
+	data.keepPlannedStatus(plannedStatus)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *CheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data resource_check.CheckModel
+	var data checkResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -518,14 +647,24 @@ func (r *CheckResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	// Delete the check
-	err := r.client.DeleteTypedCheck(r.endpointKind, data.Id.ValueString())
+	// Delete, pause or detach the check as configured by on_destroy
+	deleteCheck := func(ctx context.Context, id string) error { return r.client.DeleteTypedCheck(ctx, r.endpointKind, id) }
+	err := destroyMonitor(ctx, data.OnDestroy, data.Id.ValueString(), deleteCheck, r.client.PauseCheck)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete check, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to %s check, got error: %s", onDestroyMode(data.OnDestroy), err))
 		return
 	}
 }
 
 func (r *CheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import format: check_id, name=<name> or url=<url>
+	importStateByLookup(ctx, req, resp, r.listChecks, objectLookup[client.Check]{
+		object:   "check",
+		id:       func(c client.Check) string { return c.ID },
+		describe: func(c client.Check) string { return fmt.Sprintf("%s, %s", c.Name, c.URL) },
+		fields: map[string]func(client.Check) string{
+			"name": func(c client.Check) string { return c.Name },
+			"url":  func(c client.Check) string { return c.URL },
+		},
+	})
 }
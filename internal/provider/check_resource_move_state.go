@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/provider/resource_check"
+)
+
+var _ resource.ResourceWithMoveState = &CheckResource{}
+
+// checkSourceTypeName is the generic check resource that the typed check
+// variants accept moved state from.
+const checkSourceTypeName = "onlineornot_check"
+
+// MoveState lets the typed check variants take over state from the generic
+// onlineornot_check resource via a moved block. Both share the same schema,
+// so the state is copied once its type has been validated.
+func (r *CheckResource) MoveState(ctx context.Context) []resource.StateMover {
+	if r.forcedInputType == "" {
+		return nil
+	}
+
+	return []resource.StateMover{
+		{
+			SourceSchema: checkMoveSourceSchema(),
+			StateMover:   r.moveFromCheck,
+		},
+	}
+}
+
+// checkMoveSourceSchema is a copy of the onlineornot_check schema as last
+// released, which source state is read with. Only attribute types matter
+// for reading state, so validators, defaults and descriptions are left
+// out. It must not follow later changes to the check schema: attributes
+// added since are read by moveFromCheck from the raw state when present, as
+// listed in checkMoveAddedAttributes.
+func checkMoveSourceSchema() *schema.Schema {
+	optionalString := schema.StringAttribute{Optional: true, Computed: true}
+	optionalInt64 := schema.Int64Attribute{Optional: true, Computed: true}
+	optionalBool := schema.BoolAttribute{Optional: true, Computed: true}
+	optionalStringList := schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true}
+
+	return &schema.Schema{
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"alert_priority": optionalString,
+			"assertions": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison": schema.StringAttribute{Required: true},
+						"expected":   schema.StringAttribute{Required: true},
+						"property":   schema.StringAttribute{Required: true},
+						"type":       schema.StringAttribute{Required: true},
+					},
+				},
+				Optional: true,
+				Computed: true,
+			},
+			"auth_password":                   optionalString,
+			"auth_username":                   optionalString,
+			"body":                            optionalString,
+			"confirmation_period_seconds":     optionalInt64,
+			"discord_alerts":                  optionalStringList,
+			"follow_redirects":                optionalBool,
+			"headers":                         schema.MapAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+			"id":                              optionalString,
+			"incident_io_alerts":              optionalStringList,
+			"method":                          optionalString,
+			"microsoft_teams_alerts":          optionalStringList,
+			"name":                            schema.StringAttribute{Required: true},
+			"oncall_alerts":                   optionalStringList,
+			"recovery_period_seconds":         optionalInt64,
+			"reminder_alert_interval_minutes": optionalInt64,
+			"script":                          optionalString,
+			"slack_alerts":                    optionalStringList,
+			"telegram_alerts":                 optionalStringList,
+			"test_interval":                   optionalInt64,
+			"test_regions":                    optionalStringList,
+			"text_to_search_for":              optionalString,
+			"timeout":                         optionalInt64,
+			"type":                            optionalString,
+			"url":                             optionalString,
+			"user_alerts":                     optionalStringList,
+			"verify_ssl":                      optionalBool,
+			"version":                         optionalString,
+			"webhook_alerts":                  optionalStringList,
+		},
+	}
+}
+
+// checkMoveAddedAttributes holds the attributes added to onlineornot_check
+// after the schema pinned by checkMoveSourceSchema. State written by the
+// released provider lacks them, so each is null unless the raw source state
+// has it.
+type checkMoveAddedAttributes struct {
+	AdoptExisting *bool   `json:"adopt_existing"`
+	OnDestroy     *string `json:"on_destroy"`
+	Paused        *bool   `json:"paused"`
+	Status        *string `json:"status"`
+	LastQueued    *string `json:"last_queued"`
+}
+
+func (r *CheckResource) moveFromCheck(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if req.SourceTypeName != checkSourceTypeName || !strings.HasSuffix(req.SourceProviderAddress, "/onlineornot/onlineornot") {
+		return
+	}
+
+	if req.SourceState == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Move Resource State",
+			fmt.Sprintf("The %s state could not be read with the current schema. Run terraform apply or refresh with the current provider version first, then retry the move.", checkSourceTypeName),
+		)
+		return
+	}
+
+	var check resource_check.CheckModel
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &check)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var added checkMoveAddedAttributes
+	if req.SourceRawState != nil {
+		if err := json.Unmarshal(req.SourceRawState.JSON, &added); err != nil {
+			resp.Diagnostics.AddError("Unable to Move Resource State", fmt.Sprintf("Unable to read %s state, got error: %s", checkSourceTypeName, err))
+			return
+		}
+	}
+	data := checkResourceModel{
+		CheckModel: check,
+		monitorStatusModel: monitorStatusModel{
+			Paused: types.BoolPointerValue(added.Paused),
+			Status: types.StringPointerValue(added.Status),
+		},
+		LastQueued:    types.StringPointerValue(added.LastQueued),
+		AdoptExisting: types.BoolPointerValue(added.AdoptExisting),
+		OnDestroy:     types.StringPointerValue(added.OnDestroy),
+	}
+
+	// type defaults to UPTIME_CHECK on the generic resource
+	sourceType := "UPTIME_CHECK"
+	if !data.Type.IsNull() && !data.Type.IsUnknown() {
+		sourceType = data.Type.ValueString()
+	}
+	if sourceType != r.forcedInputType {
+		resp.Diagnostics.AddError(
+			"Invalid Resource Move",
+			fmt.Sprintf("%s %s has type %s and cannot be moved to onlineornot_%s, which only manages %s checks.", checkSourceTypeName, data.Id.ValueString(), sourceType, r.typeName, r.forcedInputType),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.TargetIdentity, data.Id.ValueString())...)
+}
@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+func testMoveCheckState(t *testing.T, target resource.Resource, sourceTypeName string, check *client.Check) *resource.MoveStateResponse {
+	t.Helper()
+	ctx := context.Background()
+	r := target.(*CheckResource)
+
+	movers := r.MoveState(ctx)
+	if len(movers) != 1 {
+		t.Fatalf("expected 1 state mover, got %d", len(movers))
+	}
+
+	source := tfsdk.State{
+		Schema: *movers[0].SourceSchema,
+		Raw:    tftypes.NewValue(movers[0].SourceSchema.Type().TerraformType(ctx), nil),
+	}
+	var data checkResourceModel
+	var diags diag.Diagnostics
+	r.populateModelFromAPI(ctx, &data, check, &diags)
+	diags.Append(source.Set(ctx, &data.CheckModel)...)
+	if diags.HasError() {
+		t.Fatalf("building source state: %v", diags)
+	}
+
+	var targetSchema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &targetSchema)
+	var identitySchema resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	resp := &resource.MoveStateResponse{
+		TargetState: tfsdk.State{
+			Schema: targetSchema.Schema,
+			Raw:    tftypes.NewValue(targetSchema.Schema.Type().TerraformType(ctx), nil),
+		},
+		TargetIdentity: &tfsdk.ResourceIdentity{
+			Schema: identitySchema.IdentitySchema,
+			Raw:    tftypes.NewValue(identitySchema.IdentitySchema.Type().TerraformType(ctx), nil),
+		},
+	}
+	movers[0].StateMover(ctx, resource.MoveStateRequest{
+		SourceProviderAddress: "registry.terraform.io/onlineornot/onlineornot",
+		SourceTypeName:        sourceTypeName,
+		SourceState:           &source,
+	}, resp)
+	return resp
+}
+
+func TestCheckResource_MoveState(t *testing.T) {
+	uptime := &client.Check{ID: "c1", Name: "site", URL: "https://example.com", CheckType: "UPTIME"}
+
+	t.Run("matching type", func(t *testing.T) {
+		resp := testMoveCheckState(t, NewUptimeCheckResource(), "onlineornot_check", uptime)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+
+		var data checkResourceModel
+		resp.TargetState.Get(context.Background(), &data)
+		if data.Id.ValueString() != "c1" || data.Url.ValueString() != "https://example.com" {
+			t.Errorf("unexpected moved state: id=%s url=%s", data.Id, data.Url)
+		}
+
+		var identity idIdentityModel
+		resp.TargetIdentity.Get(context.Background(), &identity)
+		if identity.Id.ValueString() != "c1" {
+			t.Errorf("identity id = %s, want c1", identity.Id)
+		}
+	})
+
+	t.Run("mismatched type", func(t *testing.T) {
+		resp := testMoveCheckState(t, NewBrowserCheckResource(), "onlineornot_check", uptime)
+		if !resp.Diagnostics.HasError() {
+			t.Fatal("expected an error moving an uptime check to onlineornot_browser_check")
+		}
+	})
+
+	t.Run("other source", func(t *testing.T) {
+		resp := testMoveCheckState(t, NewUptimeCheckResource(), "onlineornot_heartbeat", uptime)
+		if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+			t.Error("expected the mover to skip other source resource types")
+		}
+	})
+}
+
+func TestCheckResource_MoveStateGenericCheck(t *testing.T) {
+	if movers := NewCheckResource().(*CheckResource).MoveState(context.Background()); len(movers) != 0 {
+		t.Errorf("expected no state movers on onlineornot_check, got %d", len(movers))
+	}
+}
+
+// TestCheckMoveSourceSchema guards the pinned source schema: together with
+// the attributes the mover fills in, it must describe the current check
+// schema, so that moved state has every attribute.
+func TestCheckMoveSourceSchema(t *testing.T) {
+	ctx := context.Background()
+
+	var current resource.SchemaResponse
+	NewCheckResource().Schema(ctx, resource.SchemaRequest{}, &current)
+	currentTypes := current.Schema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes
+	pinnedTypes := checkMoveSourceSchema().Type().TerraformType(ctx).(tftypes.Object).AttributeTypes
+
+	added := map[string]bool{}
+	for _, field := range reflect.VisibleFields(reflect.TypeFor[checkMoveAddedAttributes]()) {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		added[name] = true
+	}
+
+	for name, attrType := range currentTypes {
+		pinned, ok := pinnedTypes[name]
+		switch {
+		case added[name] && ok:
+			t.Errorf("%s is both pinned and filled in by the mover", name)
+		case added[name]:
+		case !ok:
+			t.Errorf("%s was added to the check schema without being filled in by the mover", name)
+		case !attrType.Equal(pinned):
+			t.Errorf("%s changed type from %s to %s without a version bump", name, pinned, attrType)
+		}
+	}
+	for name := range pinnedTypes {
+		if _, ok := currentTypes[name]; !ok {
+			t.Errorf("%s was removed from the check schema without a version bump", name)
+		}
+	}
+}
+
+// TestCheckResource_MoveStateRawState moves state through the provider
+// server, as Terraform does, from both the released schema and the current
+// one.
+func TestCheckResource_MoveStateRawState(t *testing.T) {
+	ctx := context.Background()
+	server := testProviderServer(t, "http://localhost")
+
+	released, err := os.ReadFile(filepath.Join("testdata", "state", "check.json"))
+	if err != nil {
+		t.Fatalf("reading fixture: %s", err)
+	}
+	var current map[string]any
+	if err := json.Unmarshal(released, &current); err != nil {
+		t.Fatalf("decoding fixture: %s", err)
+	}
+	delete(current, "legacy_notes")
+	current["on_destroy"] = "pause"
+	current["paused"] = true
+	current["status"] = client.StatusPaused
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		t.Fatalf("encoding state: %s", err)
+	}
+
+	schemaResp, _ := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	targetType := schemaResp.ResourceSchemas["onlineornot_uptime_check"].ValueType()
+
+	move := func(t *testing.T, state []byte) map[string]tftypes.Value {
+		resp, err := server.MoveResourceState(ctx, &tfprotov6.MoveResourceStateRequest{
+			SourceProviderAddress: "registry.terraform.io/onlineornot/onlineornot",
+			SourceTypeName:        "onlineornot_check",
+			SourceState:           &tfprotov6.RawState{JSON: state},
+			TargetTypeName:        "onlineornot_uptime_check",
+		})
+		if err != nil {
+			t.Fatalf("moving state: %s", err)
+		}
+		for _, d := range resp.Diagnostics {
+			t.Fatalf("move: %s: %s", d.Summary, d.Detail)
+		}
+		value, err := resp.TargetState.Unmarshal(targetType)
+		if err != nil {
+			t.Fatalf("decoding target state: %s", err)
+		}
+		var attrs map[string]tftypes.Value
+		value.As(&attrs)
+		return attrs
+	}
+
+	t.Run("released", func(t *testing.T) {
+		attrs := move(t, released)
+		var id string
+		attrs["id"].As(&id)
+		if id != "a1b2c3d4" {
+			t.Errorf("id = %q, want a1b2c3d4", id)
+		}
+		for _, name := range []string{"on_destroy", "paused", "status", "adopt_existing", "last_queued"} {
+			if !attrs[name].IsNull() {
+				t.Errorf("expected %s to be null for state without it, got %s", name, attrs[name])
+			}
+		}
+	})
+
+	t.Run("current", func(t *testing.T) {
+		attrs := move(t, currentJSON)
+		var onDestroy string
+		var paused bool
+		attrs["on_destroy"].As(&onDestroy)
+		attrs["paused"].As(&paused)
+		if onDestroy != "pause" || !paused {
+			t.Errorf("expected on_destroy and paused to be kept, got %s and %s", attrs["on_destroy"], attrs["paused"])
+		}
+	})
+}
@@ -1,9 +1,11 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -91,3 +93,37 @@ resource "onlineornot_check" "test" {
 }
 `, name)
 }
+
+func TestCheckCredentialsSensitive(t *testing.T) {
+	server := testProviderServer(t, "http://localhost")
+	schemaResp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("getting provider schema: %s", err)
+	}
+
+	attributes := func(attrs []*tfprotov6.SchemaAttribute) map[string]*tfprotov6.SchemaAttribute {
+		result := map[string]*tfprotov6.SchemaAttribute{}
+		for _, attr := range attrs {
+			result[attr.Name] = attr
+		}
+		return result
+	}
+
+	// List results are returned with the resource schemas, so checking the
+	// resources covers the list resources too
+	schemas := map[string]map[string]*tfprotov6.SchemaAttribute{}
+	for _, name := range []string{"onlineornot_check", "onlineornot_uptime_check", "onlineornot_browser_check"} {
+		schemas["resource "+name] = attributes(schemaResp.ResourceSchemas[name].Block.Attributes)
+	}
+	schemas["data source onlineornot_check"] = attributes(schemaResp.DataSourceSchemas["onlineornot_check"].Block.Attributes)
+	checks := attributes(schemaResp.DataSourceSchemas["onlineornot_checks"].Block.Attributes)["checks"]
+	schemas["data source onlineornot_checks"] = attributes(checks.NestedType.Attributes)
+
+	for name, attrs := range schemas {
+		for _, attr := range []string{"auth_password", "headers"} {
+			if attrs[attr] == nil || !attrs[attr].Sensitive {
+				t.Errorf("%s: expected %s to be sensitive", name, attr)
+			}
+		}
+	}
+}
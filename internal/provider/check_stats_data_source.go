@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+var _ datasource.DataSource = &CheckStatsDataSource{}
+var _ datasource.DataSourceWithConfigure = &CheckStatsDataSource{}
+
+func NewCheckStatsDataSource() datasource.DataSource {
+	return &CheckStatsDataSource{now: time.Now}
+}
+
+// CheckStatsDataSource defines the data source implementation.
+type CheckStatsDataSource struct {
+	client *client.Client
+	now    func() time.Time
+}
+
+// CheckStatsDataSourceModel describes the data source data model.
+type CheckStatsDataSourceModel struct {
+	CheckId          types.String                  `tfsdk:"check_id"`
+	Since            types.String                  `tfsdk:"since"`
+	Until            types.String                  `tfsdk:"until"`
+	UptimePercentage types.Float64                 `tfsdk:"uptime_percentage"`
+	DowntimeMinutes  types.Int64                   `tfsdk:"downtime_minutes"`
+	IncidentCount    types.Int64                   `tfsdk:"incident_count"`
+	ResponseTimes    []checkStatsResponseTimeModel `tfsdk:"response_times"`
+}
+
+type checkStatsResponseTimeModel struct {
+	Region types.String `tfsdk:"region"`
+	P50    types.Int64  `tfsdk:"p50"`
+	P90    types.Int64  `tfsdk:"p90"`
+	P95    types.Int64  `tfsdk:"p95"`
+	P99    types.Int64  `tfsdk:"p99"`
+}
+
+func (d *CheckStatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_stats"
+}
+
+func (d *CheckStatsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"check_id": schema.StringAttribute{
+			Description: "ID of the check",
+			Required:    true,
+		},
+		"uptime_percentage": schema.Float64Attribute{
+			Description: "Percentage of the time window the check was up",
+			Computed:    true,
+		},
+		"downtime_minutes": schema.Int64Attribute{
+			Description: "Total time the check was down during the time window, in minutes",
+			Computed:    true,
+		},
+		"incident_count": schema.Int64Attribute{
+			Description: "Number of times the check went down during the time window",
+			Computed:    true,
+		},
+		"response_times": schema.ListNestedAttribute{
+			Description: "Response time percentiles per test region, in milliseconds",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description: "Test region the response times were measured from",
+						Computed:    true,
+					},
+					"p50": schema.Int64Attribute{
+						Description: "Median response time",
+						Computed:    true,
+					},
+					"p90": schema.Int64Attribute{
+						Description: "90th percentile response time",
+						Computed:    true,
+					},
+					"p95": schema.Int64Attribute{
+						Description: "95th percentile response time",
+						Computed:    true,
+					},
+					"p99": schema.Int64Attribute{
+						Description: "99th percentile response time",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+	maps.Copy(attrs, timeWindowAttributes())
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches the uptime and response time statistics of a check over a time window, for example to feed SLO dashboards.",
+		Attributes:  attrs,
+	}
+}
+
+func (d *CheckStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *CheckStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CheckStatsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	since, until := parseTimeWindow(&data.Since, &data.Until, d.now(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stats, err := d.client.GetCheckStats(ctx, data.CheckId.ValueString(), since, until)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read check stats, got error: %s", err))
+		return
+	}
+
+	data.UptimePercentage = types.Float64Value(stats.UptimePercentage)
+	data.DowntimeMinutes = types.Int64Value(int64(stats.DowntimeMinutes))
+	data.IncidentCount = types.Int64Value(int64(stats.IncidentCount))
+	data.ResponseTimes = []checkStatsResponseTimeModel{}
+	for _, rt := range stats.ResponseTimes {
+		data.ResponseTimes = append(data.ResponseTimes, checkStatsResponseTimeModel{
+			Region: types.StringValue(rt.Region),
+			P50:    types.Int64Value(int64(rt.P50)),
+			P90:    types.Int64Value(int64(rt.P90)),
+			P95:    types.Int64Value(int64(rt.P95)),
+			P99:    types.Int64Value(int64(rt.P99)),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+func TestCheckStatsDataSource(t *testing.T) {
+	var query map[string][]string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/v1/checks/c1/stats" {
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		query = req.URL.Query()
+		stats := client.CheckStats{
+			CheckID:          "c1",
+			UptimePercentage: 99.9,
+			DowntimeMinutes:  43,
+			IncidentCount:    3,
+			ResponseTimes:    []client.RegionResponseTimes{{Region: "us-east-1", P50: 80, P90: 150, P95: 210, P99: 480}},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"result": stats, "success": true})
+	}))
+	defer api.Close()
+
+	server := testProviderServer(t, api.URL)
+
+	t.Run("default window", func(t *testing.T) {
+		resp, attrs := testReadDataSource(t, server, "onlineornot_check_stats", map[string]string{"check_id": "c1", "until": "2026-02-01T01:00:00+01:00"})
+		for _, d := range resp.Diagnostics {
+			t.Fatalf("read: %s: %s", d.Summary, d.Detail)
+		}
+
+		var since, until string
+		attrs["since"].As(&since)
+		attrs["until"].As(&until)
+		if since != "2026-01-02T00:00:00Z" || query["since"][0] != since {
+			t.Errorf("since = %q, query %v, want 30 days before until", since, query)
+		}
+		// Configured values are kept as written so state matches config
+		if until != "2026-02-01T01:00:00+01:00" || query["until"][0] != "2026-02-01T00:00:00Z" {
+			t.Errorf("until = %q, query %v, want the configured value", until, query)
+		}
+
+		var uptime, downtime big.Float
+		attrs["uptime_percentage"].As(&uptime)
+		attrs["downtime_minutes"].As(&downtime)
+		if uptime.String() != "99.9" || downtime.String() != "43" {
+			t.Errorf("uptime_percentage = %s, downtime_minutes = %s", uptime.String(), downtime.String())
+		}
+
+		var regions []tftypes.Value
+		attrs["response_times"].As(&regions)
+		if len(regions) != 1 {
+			t.Fatalf("expected one region, got %d", len(regions))
+		}
+		var fields map[string]tftypes.Value
+		regions[0].As(&fields)
+		var p99 big.Float
+		fields["p99"].As(&p99)
+		if p99.String() != "480" {
+			t.Errorf("p99 = %s, want 480", p99.String())
+		}
+	})
+
+	t.Run("since after until", func(t *testing.T) {
+		until := time.Now().UTC().Format(time.RFC3339)
+		resp, _ := testReadDataSource(t, server, "onlineornot_check_stats", map[string]string{"check_id": "c1", "since": "2099-01-01T00:00:00Z", "until": until})
+		if len(resp.Diagnostics) == 0 || resp.Diagnostics[0].Summary != "Invalid Time Window" {
+			t.Fatalf("expected an invalid time window error, got %v", resp.Diagnostics)
+		}
+	})
+
+	t.Run("invalid time", func(t *testing.T) {
+		resp, _ := testReadDataSource(t, server, "onlineornot_check_stats", map[string]string{"check_id": "c1", "since": "yesterday"})
+		if len(resp.Diagnostics) == 0 || resp.Diagnostics[0].Summary != "Invalid Time" {
+			t.Fatalf("expected an invalid time error, got %v", resp.Diagnostics)
+		}
+	})
+}
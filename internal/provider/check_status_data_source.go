@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+// checkStatusDefaultRequire is the status checks must be in when require is
+// not set
+const checkStatusDefaultRequire = "UP"
+
+var _ datasource.DataSource = &CheckStatusDataSource{}
+var _ datasource.DataSourceWithConfigure = &CheckStatusDataSource{}
+
+func NewCheckStatusDataSource() datasource.DataSource {
+	return &CheckStatusDataSource{}
+}
+
+// CheckStatusDataSource defines the data source implementation.
+type CheckStatusDataSource struct {
+	client *client.Client
+}
+
+// CheckStatusDataSourceModel describes the data source data model.
+type CheckStatusDataSourceModel struct {
+	Require  types.String       `tfsdk:"require"`
+	CheckIds types.List         `tfsdk:"check_ids"`
+	WarnOnly types.Bool         `tfsdk:"warn_only"`
+	Ok       types.Bool         `tfsdk:"ok"`
+	Checks   []checkStatusModel `tfsdk:"checks"`
+}
+
+type checkStatusModel struct {
+	Id     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Status types.String `tfsdk:"status"`
+}
+
+func (d *CheckStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_status"
+}
+
+func (d *CheckStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Gates a Terraform run on the current status of checks. Reading the data source fails the plan when any check is not in the required status, so infrastructure changes are not applied while production is already failing.",
+		Attributes: map[string]schema.Attribute{
+			"require": schema.StringAttribute{
+				Description: fmt.Sprintf("The status every check must be in, e.g. `UP`. Case-insensitive. Defaults to %q.", checkStatusDefaultRequire),
+				Optional:    true,
+			},
+			"check_ids": schema.ListAttribute{
+				Description: "IDs of the checks to gate on. Defaults to every check that is not paused.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"warn_only": schema.BoolAttribute{
+				Description: "Report checks that are not in the required status as a warning instead of failing the plan. Defaults to false.",
+				Optional:    true,
+			},
+			"ok": schema.BoolAttribute{
+				Description: "Whether every check is in the required status",
+				Computed:    true,
+			},
+			"checks": schema.ListNestedAttribute{
+				Description: "The current status of each gated check",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Check ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the check",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Current status of the check",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CheckStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *CheckStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CheckStatusDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	require := checkStatusDefaultRequire
+	if !data.Require.IsNull() {
+		require = data.Require.ValueString()
+	}
+
+	var checks []client.Check
+	if data.CheckIds.IsNull() {
+		all, err := d.client.ListChecks(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read checks, got error: %s", err))
+			return
+		}
+		for _, check := range all {
+			if check.Status != client.StatusPaused {
+				checks = append(checks, check)
+			}
+		}
+	} else {
+		var ids []string
+		resp.Diagnostics.Append(data.CheckIds.ElementsAs(ctx, &ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, id := range ids {
+			check, err := d.client.GetTypedCheck(ctx, "", id)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read check %s, got error: %s", id, err))
+				return
+			}
+			checks = append(checks, *check)
+		}
+	}
+
+	data.Checks = []checkStatusModel{}
+	var failing []string
+	for _, check := range checks {
+		data.Checks = append(data.Checks, checkStatusModel{
+			Id:     types.StringValue(check.ID),
+			Name:   types.StringValue(check.Name),
+			Status: types.StringValue(check.Status),
+		})
+		if !strings.EqualFold(check.Status, require) {
+			failing = append(failing, fmt.Sprintf("%s (%s) is %s", check.Name, check.ID, check.Status))
+		}
+	}
+	data.Ok = types.BoolValue(len(failing) == 0)
+
+	if len(failing) > 0 {
+		summary := fmt.Sprintf("Checks Not %s", strings.ToUpper(require))
+		detail := fmt.Sprintf("%d of %d checks are not %s:\n\n%s", len(failing), len(checks), strings.ToUpper(require), strings.Join(failing, "\n"))
+		if data.WarnOnly.ValueBool() {
+			resp.Diagnostics.AddWarning(summary, detail)
+		} else {
+			resp.Diagnostics.AddError(summary, detail)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
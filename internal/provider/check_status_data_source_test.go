@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+func TestCheckStatusDataSource(t *testing.T) {
+	checks := map[string]client.Check{
+		"c1": {ID: "c1", Name: "api", Status: "UP"},
+		"c2": {ID: "c2", Name: "web", Status: "DOWN"},
+		"c3": {ID: "c3", Name: "legacy", Status: client.StatusPaused},
+	}
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if req.URL.Path == "/v1/checks" {
+			json.NewEncoder(w).Encode(map[string]any{"result": []client.Check{checks["c1"], checks["c2"], checks["c3"]}, "success": true})
+			return
+		}
+		check, ok := checks[strings.TrimPrefix(req.URL.Path, "/v1/checks/")]
+		if !ok {
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		json.NewEncoder(w).Encode(map[string]any{"result": check, "success": true})
+	}))
+	defer api.Close()
+
+	server := testProviderServer(t, api.URL)
+
+	checkIDs := func(ids ...string) tftypes.Value {
+		var values []tftypes.Value
+		for _, id := range ids {
+			values = append(values, tftypes.NewValue(tftypes.String, id))
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, values)
+	}
+
+	cases := map[string]struct {
+		config   map[string]tftypes.Value
+		severity tfprotov6.DiagnosticSeverity
+	}{
+		"all up": {
+			config: map[string]tftypes.Value{"check_ids": checkIDs("c1")},
+		},
+		"paused check skipped, down check fails": {
+			config:   map[string]tftypes.Value{},
+			severity: tfprotov6.DiagnosticSeverityError,
+		},
+		"warn only": {
+			config:   map[string]tftypes.Value{"check_ids": checkIDs("c1", "c2"), "warn_only": tftypes.NewValue(tftypes.Bool, true)},
+			severity: tfprotov6.DiagnosticSeverityWarning,
+		},
+		"require down": {
+			config: map[string]tftypes.Value{"check_ids": checkIDs("c2"), "require": tftypes.NewValue(tftypes.String, "down")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp, attrs := testReadDataSourceValues(t, server, "onlineornot_check_status", tc.config)
+			if tc.severity == tfprotov6.DiagnosticSeverityInvalid {
+				for _, d := range resp.Diagnostics {
+					t.Fatalf("read: %s: %s", d.Summary, d.Detail)
+				}
+			} else if len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Severity != tc.severity || !strings.Contains(resp.Diagnostics[0].Detail, "web (c2) is DOWN") {
+				t.Fatalf("expected a single diagnostic about c2 with severity %v, got %v", tc.severity, resp.Diagnostics)
+			}
+			if tc.severity == tfprotov6.DiagnosticSeverityError {
+				return
+			}
+
+			var ok bool
+			attrs["ok"].As(&ok)
+			if ok != (tc.severity == tfprotov6.DiagnosticSeverityInvalid) {
+				t.Errorf("ok = %v", ok)
+			}
+		})
+	}
+}
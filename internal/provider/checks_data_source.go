@@ -2,124 +2,131 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
 )
 
-var _ datasource.DataSource = &ChecksDataSource{}
+var _ datasource.DataSourceWithConfigure = &apiCollectionDataSource[client.AnyCheck, checksItemModel]{}
 
-func NewChecksDataSource() datasource.DataSource {
-	return &ChecksDataSource{}
-}
-
-type ChecksDataSource struct {
-	client *client.Client
-}
-
-type ChecksDataSourceModel struct {
-	Checks []CheckDataModel `tfsdk:"checks"`
+// checksItemModel is a check as returned by onlineornot_checks. It keeps
+// check_type from the original data source next to the resource's type, and
+// adds the settings specific to DNS and TCP checks.
+type checksItemModel struct {
+	checkDataSourceModel
+	CheckType types.String    `tfsdk:"check_type"`
+	DNS       *checksDNSModel `tfsdk:"dns"`
+	TCP       *checksTCPModel `tfsdk:"tcp"`
 }
 
-type CheckDataModel struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	URL       types.String `tfsdk:"url"`
-	CheckType types.String `tfsdk:"check_type"`
-	Status    types.String `tfsdk:"status"`
-	Method    types.String `tfsdk:"method"`
+type checksDNSModel struct {
+	DNSDomain     types.String `tfsdk:"dns_domain"`
+	DNSRecordType types.String `tfsdk:"dns_record_type"`
+	DNSResolver   types.String `tfsdk:"dns_resolver"`
+	DNSProtocol   types.String `tfsdk:"dns_protocol"`
 }
 
-func (d *ChecksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_checks"
+type checksTCPModel struct {
+	TCPHostname   types.String `tfsdk:"tcp_hostname"`
+	TCPPort       types.Int64  `tfsdk:"tcp_port"`
+	TCPIPFamily   types.String `tfsdk:"tcp_ip_family"`
+	TCPData       types.String `tfsdk:"tcp_data"`
+	TCPShouldFail types.Bool   `tfsdk:"tcp_should_fail"`
 }
 
-func (d *ChecksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description: "Fetches the list of uptime checks.",
-		Attributes: map[string]schema.Attribute{
-			"checks": schema.ListNestedAttribute{
-				Description: "List of uptime checks",
+func NewChecksDataSource() datasource.DataSource {
+	checks := &CheckResource{}
+
+	return &apiCollectionDataSource[client.AnyCheck, checksItemModel]{
+		typeName:    "checks",
+		object:      "check",
+		description: "Fetches the list of checks of every kind, optionally filtered. Settings that only apply to DNS or TCP checks are reported in the `dns` and `tcp` attributes.",
+		resource:    NewCheckResource,
+		exclude:     []string{"adopt_existing", "on_destroy"},
+		extra: map[string]schema.Attribute{
+			"paused": pausedDataSourceAttribute("check"),
+			"check_type": schema.StringAttribute{
+				Description: "The type of check as reported by the API: `UPTIME`, `BROWSER`, `DNS` or `TCP`",
+				Computed:    true,
+			},
+			"dns": schema.SingleNestedAttribute{
+				Description: "Settings of a DNS check. Null for other kinds of check.",
 				Computed:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							Description: "The unique identifier of the check",
-							Computed:    true,
-						},
-						"name": schema.StringAttribute{
-							Description: "The name of the check",
-							Computed:    true,
-						},
-						"url": schema.StringAttribute{
-							Description: "The URL being monitored",
-							Computed:    true,
-						},
-						"check_type": schema.StringAttribute{
-							Description: "The type of check (GET, POST, etc.)",
-							Computed:    true,
-						},
-						"status": schema.StringAttribute{
-							Description: "The current status of the check",
-							Computed:    true,
-						},
-						"method": schema.StringAttribute{
-							Description: "The HTTP method used",
-							Computed:    true,
-						},
-					},
+				Attributes: map[string]schema.Attribute{
+					"dns_domain":      schema.StringAttribute{Computed: true, Description: "Domain name to query"},
+					"dns_record_type": schema.StringAttribute{Computed: true, Description: "DNS record type to query"},
+					"dns_resolver":    schema.StringAttribute{Computed: true, Description: "DNS resolver to use"},
+					"dns_protocol":    schema.StringAttribute{Computed: true, Description: "DNS protocol to use"},
+				},
+			},
+			"tcp": schema.SingleNestedAttribute{
+				Description: "Settings of a TCP check. Null for other kinds of check.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"tcp_hostname":    schema.StringAttribute{Computed: true, Description: "Hostname to connect to"},
+					"tcp_port":        schema.Int64Attribute{Computed: true, Description: "TCP port to connect to"},
+					"tcp_ip_family":   schema.StringAttribute{Computed: true, Description: "IP family to use"},
+					"tcp_data":        schema.StringAttribute{Computed: true, Description: "Data to send after connecting"},
+					"tcp_should_fail": schema.BoolAttribute{Computed: true, Description: "Whether the connection is expected to fail"},
 				},
 			},
 		},
+		filters: map[string]collectionFilter[client.AnyCheck]{
+			"name_regex": nameRegexFilter("check", "name", func(check client.AnyCheck) string { return check.Name }),
+			"status": equalFoldFilter("Only return checks currently in this status, e.g. `UP` or `DOWN`. Case-insensitive.", func(check client.AnyCheck) string {
+				return check.Status
+			}),
+			"check_type": equalFoldFilter("Only return checks of this type: `UPTIME`, `BROWSER`, `DNS` or `TCP`. Case-insensitive.", func(check client.AnyCheck) string {
+				return check.CheckType
+			}),
+			"test_region": containsFilter("Only return checks that run from this region, e.g. `aws:us-east-1`.", func(check client.AnyCheck) []string {
+				return check.TestRegions
+			}),
+			"alert_priority": equalFoldFilter("Only return checks with this alert priority, e.g. `HIGH` or `LOW`. Case-insensitive.", func(check client.AnyCheck) string {
+				return check.AlertPriority
+			}),
+		},
+		list: (*client.Client).ListAllChecks,
+		model: func(ctx context.Context, check client.AnyCheck, diags *diag.Diagnostics) checksItemModel {
+			var data checkResourceModel
+			checks.populateModelFromAPI(ctx, &data, &check.Check, diags)
+
+			item := checksItemModel{
+				checkDataSourceModel: checkDataSourceModel{
+					CheckModel:         data.CheckModel,
+					monitorStatusModel: data.monitorStatusModel,
+					LastQueued:         data.LastQueued,
+				},
+				CheckType: optionalStringValue(check.CheckType),
+			}
+			// DNS and TCP checks have no URL
+			item.Url = optionalStringValue(check.URL)
+			if check.DNS != nil {
+				var dns DNSCheckModel
+				populateDNSModel(ctx, &dns, check.DNS, diags)
+				item.DNS = &checksDNSModel{
+					DNSDomain:     dns.DNSDomain,
+					DNSRecordType: dns.DNSRecordType,
+					DNSResolver:   dns.DNSResolver,
+					DNSProtocol:   dns.DNSProtocol,
+				}
+			}
+			if check.TCP != nil {
+				var tcp TCPCheckModel
+				populateTCPModel(ctx, &tcp, check.TCP, diags)
+				item.TCP = &checksTCPModel{
+					TCPHostname:   tcp.TCPHostname,
+					TCPPort:       tcp.TCPPort,
+					TCPIPFamily:   tcp.TCPIPFamily,
+					TCPData:       tcp.TCPData,
+					TCPShouldFail: tcp.TCPShouldFail,
+				}
+			}
+			return item
+		},
 	}
 }
-
-func (d *ChecksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	c, ok := req.ProviderData.(*client.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
-		)
-		return
-	}
-
-	d.client = c
-}
-
-func (d *ChecksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data ChecksDataSourceModel
-
-	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	checks, err := d.client.ListChecks()
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read checks, got error: %s", err))
-		return
-	}
-
-	data.Checks = make([]CheckDataModel, len(checks))
-	for i, check := range checks {
-		data.Checks[i] = CheckDataModel{
-			ID:        types.StringValue(check.ID),
-			Name:      types.StringValue(check.Name),
-			URL:       types.StringValue(check.URL),
-			CheckType: types.StringValue(check.CheckType),
-			Status:    types.StringValue(check.Status),
-			Method:    types.StringValue(check.Method),
-		}
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+// apiCollectionDataSource implements a data source that returns the objects
+// from one of the client List* calls that match its optional filters. Each
+// object exposes every attribute of the matching managed resource. T is the
+// API object and M the model of a single object.
+type apiCollectionDataSource[T, M any] struct {
+	client *client.Client
+
+	// typeName is the data source type suffix and the name of the list
+	// attribute holding the results, e.g. "heartbeats"
+	typeName string
+	// object is the human readable object name used in descriptions
+	object      string
+	description string
+
+	// resource returns the managed resource whose attributes are exposed
+	resource func() resource.Resource
+	// exclude lists resource-only attributes that the data source omits
+	exclude []string
+	// extra holds object attributes that replace or add to the resource
+	// attributes
+	extra map[string]schema.Attribute
+
+	filters map[string]collectionFilter[T]
+
+	// parent, when set, names a required argument holding the ID of the
+	// object the results belong to, e.g. status_page_id. Its value is
+	// passed to listIn, which is called instead of list.
+	parent string
+	listIn func(c *client.Client, ctx context.Context, parentID string) ([]T, error)
+
+	list  func(c *client.Client, ctx context.Context) ([]T, error)
+	model func(ctx context.Context, item T, diags *diag.Diagnostics) M
+}
+
+// collectionFilter is an optional string argument that narrows the results
+// of a collection data source
+type collectionFilter[T any] struct {
+	description string
+	// predicate returns a function reporting whether an item matches value
+	predicate func(value string) (func(T) bool, error)
+}
+
+func (d *apiCollectionDataSource[T, M]) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.typeName
+}
+
+func (d *apiCollectionDataSource[T, M]) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	itemAttrs := computedAttributes(resourceSchema(ctx, d.resource()).Attributes, d.exclude...)
+	for name, attr := range d.extra {
+		itemAttrs[name] = attr
+	}
+
+	attrs := map[string]schema.Attribute{
+		d.typeName: schema.ListNestedAttribute{
+			Description: fmt.Sprintf("List of %ss matching the filters", d.object),
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: itemAttrs,
+			},
+		},
+	}
+	for name, filter := range d.filters {
+		attrs[name] = schema.StringAttribute{
+			Description: filter.description,
+			Optional:    true,
+		}
+	}
+	if d.parent != "" {
+		attrs[d.parent] = schema.StringAttribute{
+			Description: itemAttrs[d.parent].GetDescription(),
+			Required:    true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: d.description,
+		Attributes:  attrs,
+	}
+}
+
+func (d *apiCollectionDataSource[T, M]) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *apiCollectionDataSource[T, M]) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	names := make([]string, 0, len(d.filters))
+	for name := range d.filters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var predicates []func(T) bool
+	for _, name := range names {
+		var value types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		predicate, err := d.filters[name].predicate(value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Filter", fmt.Sprintf("Unable to use %s as a filter: %s", name, err))
+			continue
+		}
+		predicates = append(predicates, predicate)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var items []T
+	var err error
+	if d.parent != "" {
+		var parentID types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(d.parent), &parentID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		items, err = d.listIn(d.client, ctx, parentID.ValueString())
+	} else {
+		items, err = d.list(d.client, ctx)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %ss, got error: %s", d.object, err))
+		return
+	}
+
+	models := []M{}
+	for _, item := range items {
+		if !matchesAll(predicates, item) {
+			continue
+		}
+		models = append(models, d.model(ctx, item, &resp.Diagnostics))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// State starts as a copy of the config, so only the results are set
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(d.typeName), models)...)
+}
+
+func matchesAll[T any](predicates []func(T) bool, item T) bool {
+	for _, predicate := range predicates {
+		if !predicate(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// nameRegexFilter returns the name_regex filter shared by collection data
+// sources
+func nameRegexFilter[T any](object, field string, name func(T) string) collectionFilter[T] {
+	return collectionFilter[T]{
+		description: fmt.Sprintf("Only return %ss whose %s matches this regular expression.", object, field),
+		predicate: func(value string) (func(T) bool, error) {
+			re, err := regexp.Compile(value)
+			if err != nil {
+				return nil, err
+			}
+			return func(item T) bool { return re.MatchString(name(item)) }, nil
+		},
+	}
+}
+
+// equalFoldFilter returns a filter matching a single field ignoring case
+func equalFoldFilter[T any](description string, field func(T) string) collectionFilter[T] {
+	return collectionFilter[T]{
+		description: description,
+		predicate: func(value string) (func(T) bool, error) {
+			return func(item T) bool { return strings.EqualFold(field(item), value) }, nil
+		},
+	}
+}
+
+// containsFilter returns a filter matching items whose list field contains
+// the value
+func containsFilter[T any](description string, field func(T) []string) collectionFilter[T] {
+	return collectionFilter[T]{
+		description: description,
+		predicate: func(value string) (func(T) bool, error) {
+			return func(item T) bool { return slices.Contains(field(item), value) }, nil
+		},
+	}
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+func TestChecksDataSource(t *testing.T) {
+	checks := []client.Check{
+		{ID: "c1", Name: "api-prod", URL: "https://api.example.com", CheckType: "UPTIME", Status: "UP", TestRegions: []string{"us-east-1"}},
+		{ID: "c2", Name: "api-staging", URL: "https://staging.example.com", CheckType: "UPTIME", Status: "DOWN", TestRegions: []string{"eu-west-1"}},
+		{ID: "c3", Name: "checkout", URL: "https://example.com/checkout", CheckType: "BROWSER", Status: "UP", TestRegions: []string{"us-east-1"}},
+	}
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		result := []any{checks[0], checks[1], checks[2], map[string]any{
+			"id": "c4", "name": "apex", "check_type": "DNS", "status": "UP", "dns_domain": "example.com", "dns_record_type": "A",
+		}}
+		json.NewEncoder(w).Encode(map[string]any{"result": result, "success": true})
+	}))
+	defer api.Close()
+
+	server := testProviderServer(t, api.URL)
+
+	cases := map[string]struct {
+		config map[string]string
+		want   []string
+	}{
+		"no filters":  {nil, []string{"c1", "c2", "c3", "c4"}},
+		"name_regex":  {map[string]string{"name_regex": "^api-"}, []string{"c1", "c2"}},
+		"status":      {map[string]string{"status": "up"}, []string{"c1", "c3", "c4"}},
+		"check_type":  {map[string]string{"check_type": "browser"}, []string{"c3"}},
+		"test_region": {map[string]string{"test_region": "us-east-1", "name_regex": "api"}, []string{"c1"}},
+		"no match":    {map[string]string{"alert_priority": "HIGH"}, nil},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp, attrs := testReadDataSource(t, server, "onlineornot_checks", tc.config)
+			for _, d := range resp.Diagnostics {
+				t.Fatalf("read: %s: %s", d.Summary, d.Detail)
+			}
+
+			var items []tftypes.Value
+			attrs["checks"].As(&items)
+			var got []string
+			for _, item := range items {
+				var fields map[string]tftypes.Value
+				item.As(&fields)
+				var id string
+				fields["id"].As(&id)
+				got = append(got, id)
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("got checks %v, want %v", got, tc.want)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Errorf("got checks %v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+
+	t.Run("dns", func(t *testing.T) {
+		_, attrs := testReadDataSource(t, server, "onlineornot_checks", map[string]string{"check_type": "dns"})
+		var items []tftypes.Value
+		attrs["checks"].As(&items)
+		if len(items) != 1 {
+			t.Fatalf("expected one DNS check, got %d", len(items))
+		}
+
+		var fields, dns map[string]tftypes.Value
+		items[0].As(&fields)
+		if !fields["tcp"].IsNull() || !fields["url"].IsNull() {
+			t.Errorf("expected tcp and url to be null for a DNS check, got %v", fields)
+		}
+		fields["dns"].As(&dns)
+		var domain string
+		dns["dns_domain"].As(&domain)
+		if domain != "example.com" {
+			t.Errorf("dns_domain = %q, want example.com", domain)
+		}
+	})
+
+	t.Run("invalid regex", func(t *testing.T) {
+		resp, _ := testReadDataSource(t, server, "onlineornot_checks", map[string]string{"name_regex": "("})
+		if len(resp.Diagnostics) == 0 || resp.Diagnostics[0].Severity != tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("expected an error, got %v", resp.Diagnostics)
+		}
+	})
+}
+
+func TestStatusPageIncidentsDataSource(t *testing.T) {
+	var paths []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		paths = append(paths, req.URL.Path)
+		incidents := []client.StatusPageIncident{
+			{ID: "i1", Title: "API outage", Status: "INVESTIGATING", Components: []client.StatusPageIncidentComponent{{ID: "comp1", Status: "MAJOR_OUTAGE"}}},
+			{ID: "i2", Title: "Slow logins", Status: "MONITORING"},
+			{ID: "i3", Title: "DNS failure", Status: "RESOLVED"},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"result": incidents, "success": true})
+	}))
+	defer api.Close()
+
+	server := testProviderServer(t, api.URL)
+
+	cases := map[string]struct {
+		status string
+		want   []string
+	}{
+		"unresolved": {"unresolved", []string{"i1", "i2"}},
+		"resolved":   {"RESOLVED", []string{"i3"}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp, attrs := testReadDataSource(t, server, "onlineornot_status_page_incidents", map[string]string{"status_page_id": "sp1", "status": tc.status})
+			for _, d := range resp.Diagnostics {
+				t.Fatalf("read: %s: %s", d.Summary, d.Detail)
+			}
+
+			var items []tftypes.Value
+			attrs["status_page_incidents"].As(&items)
+			var got []string
+			for _, item := range items {
+				var fields map[string]tftypes.Value
+				item.As(&fields)
+				var id, statusPageID string
+				fields["id"].As(&id)
+				fields["status_page_id"].As(&statusPageID)
+				if statusPageID != "sp1" {
+					t.Errorf("status_page_id = %q, want sp1", statusPageID)
+				}
+				got = append(got, id)
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("got incidents %v, want %v", got, tc.want)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Errorf("got incidents %v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+
+	if paths[0] != "/v1/status_pages/sp1/incidents" {
+		t.Errorf("requested %s, want /v1/status_pages/sp1/incidents", paths[0])
+	}
+}
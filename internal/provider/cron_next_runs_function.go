@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+	// time zones must resolve on hosts without a zoneinfo database
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/robfig/cron/v3"
+)
+
+// cronNextRunsMax caps the number of run times cron_next_runs returns
+const cronNextRunsMax = 100
+
+var _ function.Function = &CronNextRunsFunction{}
+
+func NewCronNextRunsFunction() function.Function {
+	return &CronNextRunsFunction{}
+}
+
+// CronNextRunsFunction defines the cron_next_runs function implementation.
+type CronNextRunsFunction struct{}
+
+func (f *CronNextRunsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cron_next_runs"
+}
+
+func (f *CronNextRunsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "List the next run times of a cron expression",
+		Description: "Returns the next n times a five-field cron expression, such as a heartbeat report_period_cron, fires after the `from` timestamp, as RFC 3339 timestamps in the given time zone. Pass `plantimestamp()` as `from` to list upcoming runs; the result then changes on every plan, so avoid using it in resource arguments.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "expr",
+				Description: "The cron expression, e.g. `0 3 * * *`. Descriptors such as `@daily` are also accepted.",
+			},
+			function.StringParameter{
+				Name:        "timezone",
+				Description: "The IANA time zone the expression is evaluated in, e.g. `Europe/London`. An empty string means UTC.",
+			},
+			function.StringParameter{
+				Name:        "from",
+				Description: "The RFC 3339 timestamp to list runs after, e.g. `plantimestamp()`.",
+			},
+			function.Int64Parameter{
+				Name:        "n",
+				Description: fmt.Sprintf("The number of run times to return, between 1 and %d.", cronNextRunsMax),
+				Validators: []function.Int64ParameterValidator{
+					int64validator.Between(1, cronNextRunsMax),
+				},
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *CronNextRunsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expr, timezone, from string
+	var n int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expr, &timezone, &from, &n))
+	if resp.Error != nil {
+		return
+	}
+
+	schedule, err := cron.ParseStandard(expr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid cron expression: %s", err))
+		return
+	}
+
+	location := time.UTC
+	if timezone != "" {
+		location, err = time.LoadLocation(timezone)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid time zone: %s", err))
+			return
+		}
+	}
+
+	start, err := time.Parse(time.RFC3339, from)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("Invalid from timestamp: %s", err))
+		return
+	}
+
+	runs := make([]string, 0, n)
+	next := start.In(location)
+	for range n {
+		next = schedule.Next(next)
+		if next.IsZero() {
+			break
+		}
+		runs = append(runs, next.Format(time.RFC3339))
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, runs))
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCronNextRunsFunction(t *testing.T) {
+	f := NewCronNextRunsFunction()
+	from := types.StringValue("2025-03-29T12:00:00Z")
+
+	// Europe/London switches to BST overnight on 2025-03-30
+	result, err := runFunction(t, f, types.StringValue("0 3 * * *"), types.StringValue("Europe/London"), from, types.Int64Value(3))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var runs []string
+	result.(types.List).ElementsAs(context.Background(), &runs, false)
+	want := []string{"2025-03-30T03:00:00+01:00", "2025-03-31T03:00:00+01:00", "2025-04-01T03:00:00+01:00"}
+	if len(runs) != len(want) {
+		t.Fatalf("runs = %v, want %v", runs, want)
+	}
+	for i := range want {
+		if runs[i] != want[i] {
+			t.Errorf("runs[%d] = %s, want %s", i, runs[i], want[i])
+		}
+	}
+
+	// the same arguments must always give the same result
+	again, err := runFunction(t, f, types.StringValue("0 3 * * *"), types.StringValue("Europe/London"), from, types.Int64Value(3))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !again.Equal(result) {
+		t.Errorf("second call = %s, want %s", again, result)
+	}
+
+	result, err = runFunction(t, f, types.StringValue("@hourly"), types.StringValue(""), from, types.Int64Value(1))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	result.(types.List).ElementsAs(context.Background(), &runs, false)
+	if len(runs) != 1 || runs[0] != "2025-03-29T13:00:00Z" {
+		t.Errorf("runs = %v, want [2025-03-29T13:00:00Z]", runs)
+	}
+
+	if _, err := runFunction(t, f, types.StringValue("not a cron"), types.StringValue(""), from, types.Int64Value(1)); err == nil {
+		t.Error("expected an error for an invalid expression")
+	}
+	if _, err := runFunction(t, f, types.StringValue("* * * * *"), types.StringValue("Mars/Olympus"), from, types.Int64Value(1)); err == nil {
+		t.Error("expected an error for an unknown time zone")
+	}
+	if _, err := runFunction(t, f, types.StringValue("* * * * *"), types.StringValue(""), types.StringValue("tomorrow"), types.Int64Value(1)); err == nil {
+		t.Error("expected an error for an invalid from timestamp")
+	}
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// resourceSchema returns the schema of a managed resource
+func resourceSchema(ctx context.Context, r resource.Resource) resourceschema.Schema {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+// computedAttributes converts managed resource attributes into computed data
+// source attributes, so data sources expose the same attributes as the
+// resource they read. Resource-only settings named in exclude are dropped.
+func computedAttributes(attrs map[string]resourceschema.Attribute, exclude ...string) map[string]schema.Attribute {
+	result := make(map[string]schema.Attribute, len(attrs))
+	for name, attr := range attrs {
+		if slices.Contains(exclude, name) {
+			continue
+		}
+		result[name] = computedAttribute(attr)
+	}
+	return result
+}
+
+func computedAttribute(attr resourceschema.Attribute) schema.Attribute {
+	switch a := attr.(type) {
+	case resourceschema.StringAttribute:
+		return schema.StringAttribute{
+			CustomType:          a.CustomType,
+			Computed:            true,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+		}
+	case resourceschema.Int64Attribute:
+		return schema.Int64Attribute{
+			CustomType:          a.CustomType,
+			Computed:            true,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+		}
+	case resourceschema.BoolAttribute:
+		return schema.BoolAttribute{
+			CustomType:          a.CustomType,
+			Computed:            true,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+		}
+	case resourceschema.ListAttribute:
+		return schema.ListAttribute{
+			ElementType:         a.ElementType,
+			CustomType:          a.CustomType,
+			Computed:            true,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+		}
+	case resourceschema.MapAttribute:
+		return schema.MapAttribute{
+			ElementType:         a.ElementType,
+			CustomType:          a.CustomType,
+			Computed:            true,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+		}
+	case resourceschema.ListNestedAttribute:
+		return schema.ListNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				CustomType: a.NestedObject.CustomType,
+				Attributes: computedAttributes(a.NestedObject.Attributes),
+			},
+			CustomType:          a.CustomType,
+			Computed:            true,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+		}
+	case resourceschema.SingleNestedAttribute:
+		return schema.SingleNestedAttribute{
+			Attributes:          computedAttributes(a.Attributes),
+			CustomType:          a.CustomType,
+			Computed:            true,
+			Sensitive:           a.Sensitive,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+		}
+	}
+
+	// Only reached when a resource gains an attribute kind not handled above
+	panic(fmt.Sprintf("computedAttribute: unsupported attribute type %T", attr))
+}
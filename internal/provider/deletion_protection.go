@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deletionProtectionAttribute returns the schema for the deletion_protection
+// attribute of resources that are costly to recreate.
+func deletionProtectionAttribute(object string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         fmt.Sprintf("When true, destroying the %s or removing it from the configuration fails. Set it to false and apply before deleting the %s. Defaults to false.", object, object),
+		MarkdownDescription: fmt.Sprintf("When `true`, destroying the %s or removing it from the configuration fails. Set it to `false` and apply before deleting the %s. Defaults to `false`.", object, object),
+		Default:             booldefault.StaticBool(false),
+	}
+}
+
+// checkDeletionProtection adds an error and returns false when protection is
+// enabled for the object being deleted.
+func checkDeletionProtection(protection types.Bool, object, id string, diags *diag.Diagnostics) bool {
+	if !protection.ValueBool() {
+		return true
+	}
+
+	diags.AddError(
+		"Deletion Protection Enabled",
+		fmt.Sprintf("The %s %s has deletion_protection enabled and was not deleted. Set deletion_protection = false and apply the change before destroying it.", object, id),
+	)
+	return false
+}
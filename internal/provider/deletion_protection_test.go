@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckDeletionProtection(t *testing.T) {
+	cases := map[string]struct {
+		protection types.Bool
+		want       bool
+	}{
+		"unset":    {types.BoolNull(), true},
+		"disabled": {types.BoolValue(false), true},
+		"enabled":  {types.BoolValue(true), false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			if got := checkDeletionProtection(tc.protection, "status page", "sp1", &diags); got != tc.want {
+				t.Errorf("checkDeletionProtection() = %t, want %t", got, tc.want)
+			}
+			if diags.HasError() == tc.want {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+const (
+	deployMaintenanceDefaultName     = "Terraform deploy"
+	deployMaintenanceDefaultDuration = 30
+	// deployMaintenancePrivateKey stores the open window between calls
+	deployMaintenancePrivateKey = "window"
+	// deployMaintenanceExpiryFormat is appended to the window name so that
+	// windows left behind by an interrupted run can be recognised and
+	// removed. It names this ephemeral resource, so windows created by hand
+	// or by other tools are never mistaken for one of ours.
+	deployMaintenanceExpiryFormat = " [onlineornot_deploy_maintenance, expires 2006-01-02T15:04Z]"
+)
+
+// deployMaintenanceExpiryPattern matches the expiry suffix of a window name
+var deployMaintenanceExpiryPattern = regexp.MustCompile(` \[onlineornot_deploy_maintenance, expires \d{4}-\d{2}-\d{2}T\d{2}:\d{2}Z\]$`)
+
+var _ ephemeral.EphemeralResource = &DeployMaintenanceEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &DeployMaintenanceEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithRenew = &DeployMaintenanceEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &DeployMaintenanceEphemeralResource{}
+
+func NewDeployMaintenanceEphemeralResource() ephemeral.EphemeralResource {
+	return &DeployMaintenanceEphemeralResource{now: time.Now}
+}
+
+// DeployMaintenanceEphemeralResource defines the ephemeral resource implementation.
+type DeployMaintenanceEphemeralResource struct {
+	client *client.Client
+	now    func() time.Time
+}
+
+// DeployMaintenanceEphemeralResourceModel describes the ephemeral resource data model.
+type DeployMaintenanceEphemeralResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Checks          types.List   `tfsdk:"checks"`
+	Heartbeats      types.List   `tfsdk:"heartbeats"`
+	DurationMinutes types.Int64  `tfsdk:"duration_minutes"`
+	StartsAt        types.String `tfsdk:"starts_at"`
+}
+
+// deployMaintenanceWindow is the private state kept while the window is open
+type deployMaintenanceWindow struct {
+	Window   client.MaintenanceWindow `json:"window"`
+	OpenedAt time.Time                `json:"opened_at"`
+	Duration int                      `json:"duration"`
+}
+
+func (r *DeployMaintenanceEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deploy_maintenance"
+}
+
+func (r *DeployMaintenanceEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Opens a maintenance window for the duration of a Terraform run so alerts for the given checks and heartbeats are suppressed while a deploy is in progress. The window starts when Terraform opens the ephemeral resource, is extended for as long as the run needs it, and is deleted when the run finishes. OnlineOrNot maintenance windows repeat weekly, so a window left behind by a run that could not delete it silences its monitors again at the same time every week. The window name is marked with this ephemeral resource and its expiry time, for example `Terraform deploy [onlineornot_deploy_maintenance, expires 2026-01-01T10:30Z]`, and expired windows carrying that marker are deleted the next time this ephemeral resource is opened. Other windows are never deleted.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Maintenance Window ID",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: fmt.Sprintf("Name of the maintenance window. Defaults to %q.", deployMaintenanceDefaultName),
+				Optional:    true,
+			},
+			"checks": schema.ListAttribute{
+				Description: "Array of uptime check IDs to silence during the deploy",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.AtLeastOneOf(path.MatchRoot("heartbeats")),
+				},
+			},
+			"heartbeats": schema.ListAttribute{
+				Description: "Array of heartbeat IDs to silence during the deploy",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"duration_minutes": schema.Int64Attribute{
+				Description: fmt.Sprintf("How long the window lasts after it is opened or renewed, in minutes. Terraform renews the window halfway through, so it only outlives the run if Terraform exits without closing it. Defaults to %d.", deployMaintenanceDefaultDuration),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(2),
+				},
+			},
+			"starts_at": schema.StringAttribute{
+				Description: "Time the maintenance window started, in RFC 3339 format",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *DeployMaintenanceEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *DeployMaintenanceEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data DeployMaintenanceEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Name.IsNull() {
+		data.Name = types.StringValue(deployMaintenanceDefaultName)
+	}
+	if data.DurationMinutes.IsNull() {
+		data.DurationMinutes = types.Int64Value(deployMaintenanceDefaultDuration)
+	}
+
+	r.deleteExpiredWindows(ctx, &resp.Diagnostics)
+
+	// Maintenance windows are weekly schedules with no end date, so the
+	// window starts on the current day of the week at the current minute
+	// in UTC, and carries its expiry in its name in case Close never runs
+	openedAt := r.now().UTC().Truncate(time.Minute)
+	duration := int(data.DurationMinutes.ValueInt64())
+	state := deployMaintenanceWindow{
+		Window: client.MaintenanceWindow{
+			Name:            deployMaintenanceWindowName(data.Name.ValueString(), openedAt, duration),
+			StartDate:       openedAt.Format("15:04"),
+			DurationMinutes: duration,
+			DaysOfWeek:      []string{strings.ToUpper(openedAt.Weekday().String())},
+			Timezone:        "UTC",
+		},
+		OpenedAt: openedAt,
+		Duration: duration,
+	}
+	if !data.Checks.IsNull() {
+		data.Checks.ElementsAs(ctx, &state.Window.Checks, false)
+	}
+	if !data.Heartbeats.IsNull() {
+		data.Heartbeats.ElementsAs(ctx, &state.Window.Heartbeats, false)
+	}
+
+	created, err := r.client.CreateMaintenanceWindow(ctx, &state.Window)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create maintenance window, got error: %s", err))
+		return
+	}
+	state.Window.ID = created.ID
+
+	data.Id = types.StringValue(created.ID)
+	data.StartsAt = types.StringValue(openedAt.Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setDeployMaintenanceWindow(ctx, resp.Private, state)...)
+	resp.RenewAt = r.renewAt(state.Duration)
+}
+
+// Renew extends the window so it ends duration minutes from now
+func (r *DeployMaintenanceEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	state, diags := getDeployMaintenanceWindow(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || state == nil {
+		return
+	}
+
+	elapsed := int(math.Ceil(r.now().Sub(state.OpenedAt).Minutes()))
+	state.Window.DurationMinutes = elapsed + state.Duration
+	state.Window.Name = deployMaintenanceWindowName(deployMaintenanceExpiryPattern.ReplaceAllString(state.Window.Name, ""), state.OpenedAt, state.Window.DurationMinutes)
+
+	_, err := r.client.UpdateMaintenanceWindow(ctx, state.Window.ID, &state.Window)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to extend maintenance window, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setDeployMaintenanceWindow(ctx, resp.Private, *state)...)
+	resp.RenewAt = r.renewAt(state.Duration)
+}
+
+func (r *DeployMaintenanceEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	state, diags := getDeployMaintenanceWindow(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || state == nil {
+		return
+	}
+
+	err := r.client.DeleteMaintenanceWindow(ctx, state.Window.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete maintenance window %s, got error: %s\n\nThe window repeats weekly until it is deleted. It is deleted the next time onlineornot_deploy_maintenance is opened, or can be deleted in the dashboard.", state.Window.ID, err),
+		)
+		return
+	}
+}
+
+// deleteExpiredWindows deletes windows left behind by earlier runs whose
+// expiry has passed. Only windows whose name carries the marker added by
+// deployMaintenanceWindowName are considered. Failures are only warned about, as they do not stop
+// this run's window from being opened.
+func (r *DeployMaintenanceEphemeralResource) deleteExpiredWindows(ctx context.Context, diags *diag.Diagnostics) {
+	windows, err := r.client.ListMaintenanceWindows(ctx)
+	if err != nil {
+		diags.AddWarning("Unable to Clean Up Maintenance Windows", fmt.Sprintf("Unable to list maintenance windows to delete expired deploy windows, got error: %s", err))
+		return
+	}
+
+	now := r.now()
+	for _, mw := range windows {
+		expires, ok := deployMaintenanceWindowExpiry(mw.Name)
+		if !ok || expires.After(now) {
+			continue
+		}
+		if err := r.client.DeleteMaintenanceWindow(ctx, mw.ID); err != nil {
+			diags.AddWarning("Unable to Clean Up Maintenance Windows", fmt.Sprintf("Unable to delete expired maintenance window %s, got error: %s", mw.ID, err))
+		}
+	}
+}
+
+// deployMaintenanceWindowName returns name with the time a window opened at
+// openedAt and lasting duration minutes ends
+func deployMaintenanceWindowName(name string, openedAt time.Time, duration int) string {
+	expires := openedAt.Add(time.Duration(duration) * time.Minute)
+	return name + expires.UTC().Format(deployMaintenanceExpiryFormat)
+}
+
+// deployMaintenanceWindowExpiry returns the expiry recorded in a window name
+// by deployMaintenanceWindowName
+func deployMaintenanceWindowExpiry(name string) (time.Time, bool) {
+	suffix := deployMaintenanceExpiryPattern.FindString(name)
+	if suffix == "" {
+		return time.Time{}, false
+	}
+	expires, err := time.Parse(deployMaintenanceExpiryFormat, suffix)
+	return expires, err == nil
+}
+
+// renewAt schedules the next renewal halfway through the window
+func (r *DeployMaintenanceEphemeralResource) renewAt(duration int) time.Time {
+	return r.now().Add(time.Duration(duration) * time.Minute / 2)
+}
+
+// privateData is satisfied by the private state passed to ephemeral
+// resource operations
+type privateData interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+func setDeployMaintenanceWindow(ctx context.Context, private privateData, state deployMaintenanceWindow) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, err := json.Marshal(state)
+	if err != nil {
+		diags.AddError("Unable to Store Maintenance Window", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, deployMaintenancePrivateKey, value)
+}
+
+func getDeployMaintenanceWindow(ctx context.Context, private privateData) (*deployMaintenanceWindow, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, deployMaintenancePrivateKey)
+	if diags.HasError() || len(value) == 0 {
+		return nil, diags
+	}
+
+	var state deployMaintenanceWindow
+	if err := json.Unmarshal(value, &state); err != nil {
+		diags.AddError("Unable to Read Maintenance Window", err.Error())
+		return nil, diags
+	}
+	return &state, diags
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+// testProviderServer returns a provider server configured against baseURL
+func testProviderServer(t *testing.T, baseURL string) tfprotov6.ProviderServer {
+	t.Helper()
+	ctx := context.Background()
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("creating provider server: %s", err)
+	}
+
+	schemaResp, _ := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	configType := schemaResp.Provider.ValueType().(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attrType := range configType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["api_key"] = tftypes.NewValue(tftypes.String, "test")
+	values["base_url"] = tftypes.NewValue(tftypes.String, baseURL)
+
+	config, err := tfprotov6.NewDynamicValue(configType, tftypes.NewValue(configType, values))
+	if err != nil {
+		t.Fatalf("encoding provider config: %s", err)
+	}
+	resp, _ := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &config})
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("configuring provider: %s: %s", d.Summary, d.Detail)
+	}
+	return server
+}
+
+func TestDeployMaintenanceEphemeralResource(t *testing.T) {
+	ctx := context.Background()
+
+	var requests []string
+	var windows []client.MaintenanceWindow
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		if req.Method == http.MethodGet {
+			json.NewEncoder(w).Encode(map[string]any{"success": true, "result": []client.MaintenanceWindow{
+				{ID: "expired", Name: "Terraform deploy [onlineornot_deploy_maintenance, expires 2025-01-01T10:00Z]"},
+				{ID: "live", Name: "Terraform deploy [onlineornot_deploy_maintenance, expires 2999-01-01T10:00Z]"},
+				{ID: "weekly", Name: "Weekly patching"},
+				{ID: "foreign", Name: "Release freeze (expires 2025-01-01T10:00Z)"},
+			}})
+			return
+		}
+		var mw client.MaintenanceWindow
+		json.NewDecoder(req.Body).Decode(&mw)
+		windows = append(windows, mw)
+		mw.ID = "mw1"
+		json.NewEncoder(w).Encode(map[string]any{"result": mw, "success": true})
+	}))
+	defer api.Close()
+
+	server := testProviderServer(t, api.URL)
+
+	schemaResp, _ := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	configType := schemaResp.EphemeralResourceSchemas["onlineornot_deploy_maintenance"].ValueType().(tftypes.Object)
+	config, err := tfprotov6.NewDynamicValue(configType, tftypes.NewValue(configType, map[string]tftypes.Value{
+		"id":               tftypes.NewValue(tftypes.String, nil),
+		"name":             tftypes.NewValue(tftypes.String, nil),
+		"checks":           tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "c1")}),
+		"heartbeats":       tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+		"duration_minutes": tftypes.NewValue(tftypes.Number, 20),
+		"starts_at":        tftypes.NewValue(tftypes.String, nil),
+	}))
+	if err != nil {
+		t.Fatalf("encoding config: %s", err)
+	}
+
+	openResp, _ := server.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: "onlineornot_deploy_maintenance",
+		Config:   &config,
+	})
+	for _, d := range openResp.Diagnostics {
+		t.Fatalf("open: %s: %s", d.Summary, d.Detail)
+	}
+	if openResp.RenewAt.IsZero() {
+		t.Error("expected RenewAt to be set")
+	}
+	opened := windows[1]
+	if expires, ok := deployMaintenanceWindowExpiry(opened.Name); !ok || !strings.HasPrefix(opened.Name, deployMaintenanceDefaultName) || expires.Sub(time.Now()) > 21*time.Minute {
+		t.Errorf("expected the window name to record an expiry about 20 minutes away, got %q", opened.Name)
+	}
+	if opened.DurationMinutes != 20 || len(opened.Checks) != 1 || len(opened.DaysOfWeek) != 1 || opened.Timezone != "UTC" {
+		t.Errorf("unexpected window created: %+v", opened)
+	}
+
+	renewResp, _ := server.RenewEphemeralResource(ctx, &tfprotov6.RenewEphemeralResourceRequest{
+		TypeName: "onlineornot_deploy_maintenance",
+		Private:  openResp.Private,
+	})
+	for _, d := range renewResp.Diagnostics {
+		t.Fatalf("renew: %s: %s", d.Summary, d.Detail)
+	}
+	renewed := windows[2]
+	if renewed.StartDate != opened.StartDate || renewed.DurationMinutes <= opened.DurationMinutes {
+		t.Errorf("expected the renewed window to keep its start and run longer, got %+v", renewed)
+	}
+	if strings.Count(renewed.Name, "expires") != 1 {
+		t.Errorf("expected the renewed window to record a single expiry, got %q", renewed.Name)
+	}
+
+	closeResp, _ := server.CloseEphemeralResource(ctx, &tfprotov6.CloseEphemeralResourceRequest{
+		TypeName: "onlineornot_deploy_maintenance",
+		Private:  renewResp.Private,
+	})
+	for _, d := range closeResp.Diagnostics {
+		t.Fatalf("close: %s: %s", d.Summary, d.Detail)
+	}
+
+	// Only our window whose expiry has passed is cleaned up. The foreign
+	// window with a similar suffix is left alone.
+	want := []string{"GET /v1/maintenance-windows", "DELETE /v1/maintenance-windows/expired", "POST /v1/maintenance-windows", "PATCH /v1/maintenance-windows/mw1", "DELETE /v1/maintenance-windows/mw1"}
+	if len(requests) != len(want) {
+		t.Fatalf("requests = %v, want %v", requests, want)
+	}
+	for i := range want {
+		if requests[i] != want[i] {
+			t.Errorf("requests[%d] = %s, want %s", i, requests[i], want[i])
+		}
+	}
+}
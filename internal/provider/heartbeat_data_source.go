@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/provider/resource_heartbeat"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &apiLookupDataSource[client.Heartbeat, heartbeatDataSourceModel]{}
+
+// heartbeatDataSourceModel is the heartbeat resource model without its
+// resource-only settings
+type heartbeatDataSourceModel struct {
+	resource_heartbeat.HeartbeatModel
+	monitorStatusModel
+	PingUrl types.String `tfsdk:"ping_url"`
+}
+
+func NewHeartbeatDataSource() datasource.DataSource {
+	return &apiLookupDataSource[client.Heartbeat, heartbeatDataSourceModel]{
+		typeName:    "heartbeat",
+		description: "Looks up a single heartbeat by `id` or `name`.",
+		resource:    NewHeartbeatResource,
+		exclude:     []string{"adopt_existing", "on_destroy"},
+		extra: map[string]schema.Attribute{
+			"paused": pausedDataSourceAttribute("heartbeat"),
+		},
+		list: (*client.Client).ListHeartbeats,
+		lookup: objectLookup[client.Heartbeat]{
+			object:   "heartbeat",
+			id:       func(hb client.Heartbeat) string { return hb.ID },
+			describe: func(hb client.Heartbeat) string { return hb.Name },
+			fields: map[string]func(client.Heartbeat) string{
+				"id":   func(hb client.Heartbeat) string { return hb.ID },
+				"name": func(hb client.Heartbeat) string { return hb.Name },
+			},
+		},
+		model: func(ctx context.Context, hb client.Heartbeat, diags *diag.Diagnostics) heartbeatDataSourceModel {
+			data := heartbeatModelFromAPI(ctx, &hb, diags)
+			return heartbeatDataSourceModel{
+				HeartbeatModel:     data.HeartbeatModel,
+				monitorStatusModel: data.monitorStatusModel,
+				PingUrl:            data.PingUrl,
+			}
+		},
+	}
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+var _ datasource.DataSourceWithConfigure = &monitorIncidentsDataSource[client.HeartbeatIncident, heartbeatIncidentModel]{}
+
+type heartbeatIncidentModel struct {
+	monitorIncidentModel
+	MissedPings types.Int64 `tfsdk:"missed_pings"`
+}
+
+func NewHeartbeatIncidentsDataSource() datasource.DataSource {
+	return &monitorIncidentsDataSource[client.HeartbeatIncident, heartbeatIncidentModel]{
+		now:         time.Now,
+		monitor:     "heartbeat",
+		description: "Fetches the missed ping history of a heartbeat over a time window, for example for post-incident reviews.",
+		attributes: map[string]schema.Attribute{
+			"missed_pings": schema.Int64Attribute{
+				Description: "Number of expected pings that did not arrive during the incident",
+				Computed:    true,
+			},
+		},
+		list: (*client.Client).ListHeartbeatIncidents,
+		model: func(ctx context.Context, incident client.HeartbeatIncident, diags *diag.Diagnostics) heartbeatIncidentModel {
+			return heartbeatIncidentModel{
+				monitorIncidentModel: newMonitorIncidentModel(incident.ID, incident.StartedAt, incident.EndedAt, incident.DurationSeconds),
+				MissedPings:          types.Int64Value(int64(incident.MissedPings)),
+			}
+		},
+	}
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+var _ list.ListResourceWithConfigure = &apiListResource[client.Heartbeat, heartbeatListConfigModel]{}
+
+// heartbeatListConfigModel holds the filters of the heartbeat list block
+type heartbeatListConfigModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Status     types.String `tfsdk:"status"`
+}
+
+func NewHeartbeatListResource() list.ListResource {
+	return &apiListResource[client.Heartbeat, heartbeatListConfigModel]{
+		typeName: "heartbeat",
+		object:   "heartbeat",
+		schema: listschema.Schema{
+			Description: "Lists heartbeats so they can be imported with `terraform query`.",
+			Attributes: map[string]listschema.Attribute{
+				"name_prefix": namePrefixAttribute("heartbeat"),
+				"status":      statusAttribute("heartbeat"),
+			},
+		},
+		list: (*client.Client).ListHeartbeats,
+		match: func(config heartbeatListConfigModel, hb client.Heartbeat) bool {
+			return matchesPrefix(config.NamePrefix, hb.Name) && matchesFold(config.Status, hb.Status)
+		},
+		id:          func(hb client.Heartbeat) string { return hb.ID },
+		displayName: func(hb client.Heartbeat) string { return hb.Name },
+		model: func(ctx context.Context, hb client.Heartbeat, diags *diag.Diagnostics) any {
+			return heartbeatModelFromAPI(ctx, &hb, diags)
+		},
+	}
+}
+
+// heartbeatModelFromAPI builds a complete resource model from an API heartbeat
+func heartbeatModelFromAPI(ctx context.Context, hb *client.Heartbeat, diags *diag.Diagnostics) heartbeatResourceModel {
+	var data heartbeatResourceModel
+
+	data.Id = types.StringValue(hb.ID)
+	data.Name = types.StringValue(hb.Name)
+	data.GracePeriod = types.Int64Value(int64(hb.GracePeriod))
+	data.setStatus(hb.Status)
+	data.PingUrl = optionalStringValue(hb.PingURL)
+	data.ReportPeriod = optionalInt64Value(hb.ReportPeriod)
+	data.ReportPeriodCron = optionalStringValue(hb.ReportPeriodCron)
+	data.Timezone = optionalStringValue(hb.Timezone)
+	data.AlertPriority = optionalStringValue(hb.AlertPriority)
+	data.ReminderAlertIntervalMinutes = optionalInt64Value(hb.ReminderAlertIntervalMinutes)
+	data.UserAlerts = stringListValue(ctx, hb.UserAlerts, diags)
+	data.SlackAlerts = stringListValue(ctx, hb.SlackAlerts, diags)
+	data.DiscordAlerts = stringListValue(ctx, hb.DiscordAlerts, diags)
+	data.TelegramAlerts = types.ListNull(types.StringType)
+	data.WebhookAlerts = stringListValue(ctx, hb.WebhookAlerts, diags)
+	data.OncallAlerts = stringListValue(ctx, hb.OncallAlerts, diags)
+	data.IncidentIoAlerts = stringListValue(ctx, hb.IncidentIOAlerts, diags)
+	data.MicrosoftTeamsAlerts = stringListValue(ctx, hb.MicrosoftTeamsAlerts, diags)
+
+	return data
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// heartbeatPingBaseURL is where heartbeat pings are sent unless another
+// base URL is passed to the function
+const heartbeatPingBaseURL = "https://heartbeat.onlineornot.com"
+
+var _ function.Function = &HeartbeatPingURLFunction{}
+
+func NewHeartbeatPingURLFunction() function.Function {
+	return &HeartbeatPingURLFunction{}
+}
+
+// HeartbeatPingURLFunction defines the heartbeat_ping_url function implementation.
+type HeartbeatPingURLFunction struct{}
+
+func (f *HeartbeatPingURLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "heartbeat_ping_url"
+}
+
+func (f *HeartbeatPingURLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build the ping URL of a heartbeat",
+		Description: "Returns the URL a job should request to report that it ran, given the ID of an onlineornot_heartbeat. Pings go to " + heartbeatPingBaseURL + " unless a base URL is passed as the second argument. The `ping_url` attribute of the heartbeat holds the URL returned by the API and can be used instead when the heartbeat is managed in the same configuration.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The heartbeat ID.",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "base_url",
+			Description: "The base URL pings are sent to, e.g. for a proxy. At most one may be passed. Defaults to " + heartbeatPingBaseURL + ".",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *HeartbeatPingURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	var baseURLs []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id, &baseURLs))
+	if resp.Error != nil {
+		return
+	}
+
+	baseURL := heartbeatPingBaseURL
+	switch len(baseURLs) {
+	case 0:
+	case 1:
+		baseURL = strings.TrimRight(strings.TrimSpace(baseURLs[0]), "/")
+		if parsed, err := url.Parse(baseURL); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			resp.Error = function.NewArgumentFuncError(1, "Invalid base URL: expected an absolute URL such as "+heartbeatPingBaseURL)
+			return
+		}
+	default:
+		resp.Error = function.NewArgumentFuncError(2, "Too many arguments: expected at most one base URL")
+		return
+	}
+
+	id = strings.TrimSpace(id)
+	if id == "" || strings.Contains(id, "/") {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid heartbeat ID: expected a non-empty ID without slashes")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, baseURL+"/"+id))
+}
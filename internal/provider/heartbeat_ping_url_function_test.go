@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// pingBaseURLs returns the variadic base URL arguments as the framework
+// passes them to Run
+func pingBaseURLs(urls ...string) attr.Value {
+	elemTypes := make([]attr.Type, len(urls))
+	values := make([]attr.Value, len(urls))
+	for i, u := range urls {
+		elemTypes[i] = types.StringType
+		values[i] = types.StringValue(u)
+	}
+	return types.TupleValueMust(elemTypes, values)
+}
+
+func TestHeartbeatPingURLFunction(t *testing.T) {
+	result, err := runFunction(t, NewHeartbeatPingURLFunction(), types.StringValue("hb123"), pingBaseURLs())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := types.StringValue("https://heartbeat.onlineornot.com/hb123"); !result.Equal(want) {
+		t.Errorf("result = %s, want %s", result, want)
+	}
+
+	result, err = runFunction(t, NewHeartbeatPingURLFunction(), types.StringValue("hb123"), pingBaseURLs("https://ping.example.com/"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := types.StringValue("https://ping.example.com/hb123"); !result.Equal(want) {
+		t.Errorf("result = %s, want %s", result, want)
+	}
+
+	if _, err := runFunction(t, NewHeartbeatPingURLFunction(), types.StringValue("a/b"), pingBaseURLs()); err == nil {
+		t.Error("expected an error for an ID containing a slash")
+	}
+	if _, err := runFunction(t, NewHeartbeatPingURLFunction(), types.StringValue("hb123"), pingBaseURLs("ping.example.com")); err == nil {
+		t.Error("expected an error for a base URL without a scheme")
+	}
+	if _, err := runFunction(t, NewHeartbeatPingURLFunction(), types.StringValue("hb123"), pingBaseURLs("https://a.example.com", "https://b.example.com")); err == nil {
+		t.Error("expected an error for more than one base URL")
+	}
+}
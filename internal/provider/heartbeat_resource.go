@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
@@ -14,6 +17,9 @@ import (
 
 var _ resource.Resource = &HeartbeatResource{}
 var _ resource.ResourceWithImportState = &HeartbeatResource{}
+var _ resource.ResourceWithIdentity = &HeartbeatResource{}
+
+const heartbeatSchemaVersion = 0
 
 func NewHeartbeatResource() resource.Resource {
 	return &HeartbeatResource{}
@@ -23,12 +29,38 @@ type HeartbeatResource struct {
 	client *client.Client
 }
 
+// heartbeatResourceModel is the generated heartbeat model plus the pause
+// state, the ping URL reported by the API, and the adopt_existing and
+// on_destroy settings that only affect what the provider does.
+type heartbeatResourceModel struct {
+	resource_heartbeat.HeartbeatModel
+	monitorStatusModel
+	PingUrl       types.String `tfsdk:"ping_url"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
+	OnDestroy     types.String `tfsdk:"on_destroy"`
+}
+
 func (r *HeartbeatResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_heartbeat"
 }
 
 func (r *HeartbeatResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_heartbeat.HeartbeatResourceSchema(ctx)
+	resp.Schema.Version = heartbeatSchemaVersion
+	resp.Schema.Attributes["adopt_existing"] = adoptExistingAttribute("heartbeat", "name")
+	addMonitorStatusAttributes(resp.Schema.Attributes, "heartbeat")
+	resp.Schema.Attributes["ping_url"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "URL the monitored job requests to report that it ran, as returned by the API",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	resp.Schema.Attributes["on_destroy"] = onDestroyAttribute("heartbeat")
+}
+
+func (r *HeartbeatResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Heartbeat")
 }
 
 func (r *HeartbeatResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -49,7 +81,7 @@ func (r *HeartbeatResource) Configure(ctx context.Context, req resource.Configur
 }
 
 func (r *HeartbeatResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data resource_heartbeat.HeartbeatModel
+	var data heartbeatResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -88,13 +120,41 @@ func (r *HeartbeatResource) Create(ctx context.Context, req resource.CreateReque
 		data.MicrosoftTeamsAlerts.ElementsAs(ctx, &hb.MicrosoftTeamsAlerts, false)
 	}
 
-	created, err := r.client.CreateHeartbeat(hb)
+	var created *client.Heartbeat
+	if data.AdoptExisting.ValueBool() {
+		existingID := r.findExistingHeartbeat(ctx, hb, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if existingID != "" {
+			adopted, err := r.client.UpdateHeartbeat(ctx, existingID, hb)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to adopt existing heartbeat %s, got error: %s", existingID, err))
+				return
+			}
+			addAdoptedWarning(&resp.Diagnostics, "heartbeat", existingID)
+			created = adopted
+		}
+	}
+
+	if created == nil {
+		var err error
+		created, err = r.client.CreateHeartbeat(ctx, hb)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create heartbeat, got error: %s", err))
+			return
+		}
+	}
+
+	status, err := applyPaused(ctx, data.Paused, created.ID, created.Status, r.client.PauseHeartbeat, r.client.ResumeHeartbeat)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create heartbeat, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update paused state of heartbeat %s, got error: %s", created.ID, err))
 		return
 	}
 
 	data.Id = types.StringValue(created.ID)
+	data.PingUrl = optionalStringValue(created.PingURL)
+	data.setStatus(status)
 
 	// Set computed fields to null to avoid "unknown after apply" errors
 	if data.AlertPriority.IsUnknown() {
@@ -135,17 +195,18 @@ func (r *HeartbeatResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *HeartbeatResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data resource_heartbeat.HeartbeatModel
+	var data heartbeatResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	hb, err := r.client.GetHeartbeat(data.Id.ValueString())
+	hb, err := r.client.GetHeartbeat(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read heartbeat, got error: %s", err))
 		return
@@ -154,12 +215,15 @@ func (r *HeartbeatResource) Read(ctx context.Context, req resource.ReadRequest,
 	data.Id = types.StringValue(hb.ID)
 	data.Name = types.StringValue(hb.Name)
 	data.GracePeriod = types.Int64Value(int64(hb.GracePeriod))
+	data.PingUrl = optionalStringValue(hb.PingURL)
+	data.setStatus(hb.Status)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *HeartbeatResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data resource_heartbeat.HeartbeatModel
+	var data heartbeatResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -198,30 +262,67 @@ func (r *HeartbeatResource) Update(ctx context.Context, req resource.UpdateReque
 		data.MicrosoftTeamsAlerts.ElementsAs(ctx, &hb.MicrosoftTeamsAlerts, false)
 	}
 
-	_, err := r.client.UpdateHeartbeat(data.Id.ValueString(), hb)
+	updated, err := r.client.UpdateHeartbeat(ctx, data.Id.ValueString(), hb)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update heartbeat, got error: %s", err))
 		return
 	}
 
+	status, err := applyPaused(ctx, data.Paused, data.Id.ValueString(), updated.Status, r.client.PauseHeartbeat, r.client.ResumeHeartbeat)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update paused state of heartbeat %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+	plannedStatus := data.Status
+	data.setStatus(status)
+	data.keepPlannedStatus(plannedStatus)
+	if data.PingUrl.IsUnknown() {
+		data.PingUrl = optionalStringValue(updated.PingURL)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *HeartbeatResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data resource_heartbeat.HeartbeatModel
+	var data heartbeatResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := r.client.DeleteHeartbeat(data.Id.ValueString())
+	err := destroyMonitor(ctx, data.OnDestroy, data.Id.ValueString(), r.client.DeleteHeartbeat, r.client.PauseHeartbeat)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete heartbeat, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to %s heartbeat, got error: %s", onDestroyMode(data.OnDestroy), err))
 		return
 	}
 }
 
+// findExistingHeartbeat returns the ID of an existing heartbeat with the same
+// name, or "" when there is none.
+func (r *HeartbeatResource) findExistingHeartbeat(ctx context.Context, hb *client.Heartbeat, diags *diag.Diagnostics) string {
+	heartbeats, err := r.client.ListHeartbeats(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list heartbeats to adopt, got error: %s", err))
+		return ""
+	}
+
+	return findAdoptionCandidate(heartbeats, "heartbeat",
+		func(h client.Heartbeat) string { return h.ID },
+		func(h client.Heartbeat) bool { return h.Name == hb.Name },
+		diags,
+	)
+}
+
 func (r *HeartbeatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import format: heartbeat_id or name=<name>
+	importStateByLookup(ctx, req, resp, r.client.ListHeartbeats, objectLookup[client.Heartbeat]{
+		object:   "heartbeat",
+		id:       func(h client.Heartbeat) string { return h.ID },
+		describe: func(h client.Heartbeat) string { return h.Name },
+		fields: map[string]func(client.Heartbeat) string{
+			"name": func(h client.Heartbeat) string { return h.Name },
+		},
+	})
 }
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// idIdentityModel is the identity of resources addressed by their own ID.
+type idIdentityModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+// childIdentityModel is the identity of resources that live under a status
+// page and are addressed by the page ID and their own ID.
+type childIdentityModel struct {
+	StatusPageId types.String `tfsdk:"status_page_id"`
+	Id           types.String `tfsdk:"id"`
+}
+
+// idIdentitySchema returns the identity schema for resources addressed by a
+// single ID.
+func idIdentitySchema(object string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       fmt.Sprintf("%s ID", object),
+			},
+		},
+	}
+}
+
+// childIdentitySchema returns the identity schema for status page child
+// resources.
+func childIdentitySchema(object string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"status_page_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Status Page ID",
+			},
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       fmt.Sprintf("%s ID", object),
+			},
+		},
+	}
+}
+
+// setIDIdentity records id as the resource identity. Older Terraform versions
+// do not send identity data, in which case there is nothing to set.
+func setIDIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id string) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, idIdentityModel{Id: types.StringValue(id)})
+}
+
+// setChildIdentity records the status page ID and child ID as the resource
+// identity.
+func setChildIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, statusPageID, id string) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, childIdentityModel{
+		StatusPageId: types.StringValue(statusPageID),
+		Id:           types.StringValue(id),
+	})
+}
+
+// importChildState imports a status page child resource either from an
+// identity block or from an import ID of the form status_page_id/child_id,
+// where child names the second segment in error messages.
+func importChildState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, child string) {
+	var identity childIdentityModel
+
+	if req.ID != "" {
+		statusPageID, id, ok := parseChildID(req.ID)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Expected import ID format: status_page_id/%s, got: %s", child, req.ID),
+			)
+			return
+		}
+		identity.StatusPageId = types.StringValue(statusPageID)
+		identity.Id = types.StringValue(id)
+	} else {
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("status_page_id"), identity.StatusPageId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.Id)...)
+}
+
+// parseChildID splits a "status_page_id/id" pair into its two parts
+func parseChildID(value string) (statusPageID, id string, ok bool) {
+	statusPageID, id, ok = strings.Cut(value, "/")
+	if !ok || statusPageID == "" || id == "" || strings.Contains(id, "/") {
+		return "", "", false
+	}
+	return statusPageID, id, true
+}
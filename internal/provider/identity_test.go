@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testChildImport(t *testing.T, id string, identity *childIdentityModel) (*resource.ImportStateResponse, types.String, types.String) {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &StatusPageIncidentResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identitySchemaResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	req := resource.ImportStateRequest{
+		ID: id,
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identitySchemaResp.IdentitySchema,
+			Raw:    tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+		},
+	}
+	if identity != nil {
+		if diags := req.Identity.Set(ctx, identity); diags.HasError() {
+			t.Fatalf("setting identity: %v", diags)
+		}
+	}
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	r.ImportState(ctx, req, resp)
+
+	var statusPageID, childID types.String
+	if !resp.Diagnostics.HasError() {
+		resp.State.GetAttribute(ctx, path.Root("status_page_id"), &statusPageID)
+		resp.State.GetAttribute(ctx, path.Root("id"), &childID)
+	}
+	return resp, statusPageID, childID
+}
+
+func TestImportChildState(t *testing.T) {
+	t.Run("import ID", func(t *testing.T) {
+		resp, statusPageID, id := testChildImport(t, "page1/inc1", nil)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		if statusPageID.ValueString() != "page1" || id.ValueString() != "inc1" {
+			t.Errorf("got status_page_id=%s id=%s, want page1 and inc1", statusPageID, id)
+		}
+	})
+
+	t.Run("identity", func(t *testing.T) {
+		resp, statusPageID, id := testChildImport(t, "", &childIdentityModel{
+			StatusPageId: types.StringValue("page2"),
+			Id:           types.StringValue("inc2"),
+		})
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		if statusPageID.ValueString() != "page2" || id.ValueString() != "inc2" {
+			t.Errorf("got status_page_id=%s id=%s, want page2 and inc2", statusPageID, id)
+		}
+	})
+
+	for _, id := range []string{"inc1", "page1/", "/inc1", "page1/inc1/extra"} {
+		t.Run("invalid "+id, func(t *testing.T) {
+			resp, _, _ := testChildImport(t, id, nil)
+			if !resp.Diagnostics.HasError() {
+				t.Errorf("expected an error for import ID %q", id)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// objectLookup describes how to match API objects by a key and value, such
+// as name=Marketing site. It resolves key=value import IDs and the lookup
+// attributes of data sources.
+type objectLookup[T any] struct {
+	// object is the human readable object name used in diagnostics
+	object string
+	// id returns the object ID written to state
+	id func(T) string
+	// describe returns a short label shown next to each ambiguous candidate
+	describe func(T) string
+	// fields maps each supported lookup key to the value it matches against
+	fields map[string]func(T) string
+}
+
+// importStateByLookup imports a resource either by its plain ID or by a
+// key=value lookup resolved through l against the objects returned by list.
+// Plain IDs and identity blocks are passed through untouched.
+func importStateByLookup[T any](ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, list func(ctx context.Context) ([]T, error), l objectLookup[T]) {
+	key, value, isLookup := strings.Cut(req.ID, "=")
+	if !isLookup {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+
+	key = strings.TrimSpace(key)
+	if _, ok := l.fields[key]; !ok {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Unsupported import lookup %q. Expected an ID or one of: %s, got: %s", key, strings.Join(l.lookupForms(), ", "), req.ID),
+		)
+		return
+	}
+
+	items, err := list(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list %ss for import, got error: %s", l.object, err))
+		return
+	}
+
+	id := l.resolve(items, key, value, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// resolve returns the ID of the single item whose key field equals value. It
+// adds an error diagnostic when nothing matches or when several items match.
+func (l objectLookup[T]) resolve(items []T, key, value string, diags *diag.Diagnostics) string {
+	matches := l.matches(items, key, value)
+
+	switch len(matches) {
+	case 0:
+		diags.AddError(
+			"Cannot Import Non-Existent Remote Object",
+			fmt.Sprintf("No %s found with %s %q.", l.object, key, value),
+		)
+		return ""
+	case 1:
+		return l.id(matches[0])
+	}
+
+	diags.AddError(
+		"Ambiguous Import ID",
+		fmt.Sprintf("%d %ss match %s %q. Import one of them by ID instead:\n%s", len(matches), l.object, key, value, l.candidates(matches)),
+	)
+	return ""
+}
+
+// matches returns the items whose key field equals value
+func (l objectLookup[T]) matches(items []T, key, value string) []T {
+	field := l.fields[key]
+
+	var matches []T
+	for _, item := range items {
+		if field(item) == value {
+			matches = append(matches, item)
+		}
+	}
+	return matches
+}
+
+// candidates lists ambiguous matches one per line for use in diagnostics
+func (l objectLookup[T]) candidates(matches []T) string {
+	lines := make([]string, 0, len(matches))
+	for _, item := range matches {
+		lines = append(lines, fmt.Sprintf("  - %s (%s)", l.id(item), l.describe(item)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// lookupForms returns the supported key=... forms in a stable order
+func (l objectLookup[T]) lookupForms() []string {
+	forms := make([]string, 0, len(l.fields))
+	for key := range l.fields {
+		forms = append(forms, key+"=...")
+	}
+	sort.Strings(forms)
+	return forms
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+func testCheckImportLookup() objectLookup[client.Check] {
+	return objectLookup[client.Check]{
+		object:   "check",
+		id:       func(c client.Check) string { return c.ID },
+		describe: func(c client.Check) string { return c.URL },
+		fields: map[string]func(client.Check) string{
+			"name": func(c client.Check) string { return c.Name },
+			"url":  func(c client.Check) string { return c.URL },
+		},
+	}
+}
+
+func TestImportLookup_Resolve(t *testing.T) {
+	checks := []client.Check{
+		{ID: "a1", Name: "Marketing site", URL: "https://example.com"},
+		{ID: "b2", Name: "API", URL: "https://api.example.com"},
+		{ID: "c3", Name: "API", URL: "https://api.example.org"},
+	}
+	lookup := testCheckImportLookup()
+
+	var diags diag.Diagnostics
+	if id := lookup.resolve(checks, "name", "Marketing site", &diags); id != "a1" || diags.HasError() {
+		t.Errorf("name lookup = %q, %v; want a1", id, diags)
+	}
+	if id := lookup.resolve(checks, "url", "https://api.example.org", &diags); id != "c3" || diags.HasError() {
+		t.Errorf("url lookup = %q, %v; want c3", id, diags)
+	}
+
+	diags = nil
+	lookup.resolve(checks, "name", "Missing", &diags)
+	if !diags.HasError() {
+		t.Error("expected an error when nothing matches")
+	}
+
+	diags = nil
+	lookup.resolve(checks, "name", "API", &diags)
+	if !diags.HasError() {
+		t.Fatal("expected an error for an ambiguous match")
+	}
+	detail := diags.Errors()[0].Detail()
+	for _, want := range []string{"b2 (https://api.example.com)", "c3 (https://api.example.org)"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("ambiguous diagnostic %q does not list candidate %q", detail, want)
+		}
+	}
+}
+
+func TestImportLookup_LookupForms(t *testing.T) {
+	got := strings.Join(testCheckImportLookup().lookupForms(), ", ")
+	if want := "name=..., url=..."; got != want {
+		t.Errorf("lookupForms() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+// monitorIncidentsDataSource lists the incidents of a single monitor over a
+// time window. T is the API incident type and M the Terraform model of one
+// incident.
+type monitorIncidentsDataSource[T, M any] struct {
+	client *client.Client
+	now    func() time.Time
+
+	// monitor names the kind of monitor, e.g. check. The monitor is
+	// selected with the <monitor>_id argument.
+	monitor     string
+	description string
+	// attributes are the incident attributes beyond those shared by every
+	// monitor, see monitorIncidentModel
+	attributes map[string]schema.Attribute
+
+	list  func(c *client.Client, ctx context.Context, id string, since, until time.Time) ([]T, error)
+	model func(ctx context.Context, incident T, diags *diag.Diagnostics) M
+}
+
+// monitorIncidentModel holds the incident attributes shared by every
+// monitor
+type monitorIncidentModel struct {
+	Id              types.String `tfsdk:"id"`
+	StartedAt       types.String `tfsdk:"started_at"`
+	EndedAt         types.String `tfsdk:"ended_at"`
+	DurationSeconds types.Int64  `tfsdk:"duration_seconds"`
+}
+
+func newMonitorIncidentModel(id, startedAt, endedAt string, durationSeconds int) monitorIncidentModel {
+	return monitorIncidentModel{
+		Id:              types.StringValue(id),
+		StartedAt:       types.StringValue(startedAt),
+		EndedAt:         optionalStringValue(endedAt),
+		DurationSeconds: types.Int64Value(int64(durationSeconds)),
+	}
+}
+
+func (d *monitorIncidentsDataSource[T, M]) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.monitor + "_incidents"
+}
+
+func (d *monitorIncidentsDataSource[T, M]) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	itemAttrs := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Incident ID",
+			Computed:    true,
+		},
+		"started_at": schema.StringAttribute{
+			Description: "Time the incident started, in RFC 3339 format",
+			Computed:    true,
+		},
+		"ended_at": schema.StringAttribute{
+			Description: "Time the incident ended, in RFC 3339 format. Null while the incident is ongoing.",
+			Computed:    true,
+		},
+		"duration_seconds": schema.Int64Attribute{
+			Description: "How long the incident lasted, or has lasted so far, in seconds",
+			Computed:    true,
+		},
+	}
+	maps.Copy(itemAttrs, d.attributes)
+
+	attrs := map[string]schema.Attribute{
+		d.monitor + "_id": schema.StringAttribute{
+			Description: fmt.Sprintf("ID of the %s", d.monitor),
+			Required:    true,
+		},
+		"incidents": schema.ListNestedAttribute{
+			Description: "Incidents overlapping the time window, oldest first",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: itemAttrs,
+			},
+		},
+	}
+	maps.Copy(attrs, timeWindowAttributes())
+
+	resp.Schema = schema.Schema{
+		Description: d.description,
+		Attributes:  attrs,
+	}
+}
+
+func (d *monitorIncidentsDataSource[T, M]) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *monitorIncidentsDataSource[T, M]) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var id, sinceValue, untilValue types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(d.monitor+"_id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("since"), &sinceValue)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("until"), &untilValue)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	since, until := parseTimeWindow(&sinceValue, &untilValue, d.now(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	incidents, err := d.list(d.client, ctx, id.ValueString(), since, until)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s incidents, got error: %s", d.monitor, err))
+		return
+	}
+
+	models := []M{}
+	for _, incident := range incidents {
+		models = append(models, d.model(ctx, incident, &resp.Diagnostics))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// State starts as a copy of the config, so only the computed values
+	// are set
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("since"), sinceValue)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("until"), untilValue)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("incidents"), models)...)
+}
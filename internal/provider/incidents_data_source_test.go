@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+func TestMonitorIncidentsDataSource(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var result any
+		switch req.URL.Path {
+		case "/v1/checks/c1/incidents":
+			result = []client.CheckIncident{
+				{ID: "inc1", StartedAt: "2026-01-02T10:00:00Z", EndedAt: "2026-01-02T10:05:00Z", DurationSeconds: 300, Regions: []string{"us-east-1", "eu-west-1"}, Reason: "HTTP 503"},
+			}
+		case "/v1/heartbeats/hb1/incidents":
+			result = []client.HeartbeatIncident{
+				{ID: "inc2", StartedAt: "2026-01-03T00:00:00Z", DurationSeconds: 7200, MissedPings: 2},
+			}
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"result": result, "success": true})
+	}))
+	defer api.Close()
+
+	server := testProviderServer(t, api.URL)
+
+	readIncident := func(t *testing.T, typeName string, config map[string]string) map[string]tftypes.Value {
+		t.Helper()
+		resp, attrs := testReadDataSource(t, server, typeName, config)
+		for _, d := range resp.Diagnostics {
+			t.Fatalf("read: %s: %s", d.Summary, d.Detail)
+		}
+		var incidents []tftypes.Value
+		attrs["incidents"].As(&incidents)
+		if len(incidents) != 1 {
+			t.Fatalf("expected one incident, got %d", len(incidents))
+		}
+		var fields map[string]tftypes.Value
+		incidents[0].As(&fields)
+		return fields
+	}
+
+	t.Run("check", func(t *testing.T) {
+		fields := readIncident(t, "onlineornot_check_incidents", map[string]string{"check_id": "c1"})
+		var reason string
+		var regions []tftypes.Value
+		fields["reason"].As(&reason)
+		fields["regions"].As(&regions)
+		if reason != "HTTP 503" || len(regions) != 2 || fields["ended_at"].IsNull() {
+			t.Errorf("unexpected incident %v", fields)
+		}
+	})
+
+	t.Run("ongoing heartbeat", func(t *testing.T) {
+		fields := readIncident(t, "onlineornot_heartbeat_incidents", map[string]string{"heartbeat_id": "hb1"})
+		if !fields["ended_at"].IsNull() {
+			t.Errorf("expected ended_at to be null for an ongoing incident, got %v", fields["ended_at"])
+		}
+		if fields["missed_pings"].IsNull() {
+			t.Error("expected missed_pings to be set")
+		}
+	})
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &apiLookupDataSource[client.Integration, integrationModel]{}
+var _ datasource.DataSourceWithConfigValidators = &apiLookupDataSource[client.Integration, oncallScheduleModel]{}
+
+type integrationModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+type oncallScheduleModel struct {
+	integrationModel
+	Service types.String `tfsdk:"service"`
+}
+
+func NewSlackChannelDataSource() datasource.DataSource {
+	return newIntegrationDataSource("slack_channel", "Slack channel", "slack_alerts", (*client.Client).ListSlackChannels)
+}
+
+func NewDiscordChannelDataSource() datasource.DataSource {
+	return newIntegrationDataSource("discord_channel", "Discord channel", "discord_alerts", (*client.Client).ListDiscordChannels)
+}
+
+func NewTeamsChannelDataSource() datasource.DataSource {
+	return newIntegrationDataSource("teams_channel", "Microsoft Teams channel", "microsoft_teams_alerts", (*client.Client).ListMicrosoftTeamsChannels)
+}
+
+func NewIncidentIOIntegrationDataSource() datasource.DataSource {
+	return newIntegrationDataSource("incident_io_integration", "incident.io integration", "incident_io_alerts", (*client.Client).ListIncidentIOIntegrations)
+}
+
+func NewOncallScheduleDataSource() datasource.DataSource {
+	extra := integrationAttributes("on-call schedule", "oncall_alerts")
+	extra["service"] = schema.StringAttribute{
+		Description: "On-call service the schedule belongs to, e.g. PAGERDUTY",
+		Computed:    true,
+	}
+
+	return &apiLookupDataSource[client.Integration, oncallScheduleModel]{
+		typeName:    "oncall_schedule",
+		description: integrationDescription("on-call schedule (Grafana, PagerDuty, Opsgenie, Spike)", "oncall_alerts"),
+		extra:       extra,
+		list:        (*client.Client).ListOncallIntegrations,
+		lookup:      integrationLookup("on-call schedule"),
+		model: func(ctx context.Context, integration client.Integration, diags *diag.Diagnostics) oncallScheduleModel {
+			return oncallScheduleModel{
+				integrationModel: integrationModelFromAPI(integration),
+				Service:          optionalStringValue(integration.Provider),
+			}
+		},
+	}
+}
+
+// newIntegrationDataSource returns a data source that looks up an
+// integration by name so its ID can be used in alertsAttr
+func newIntegrationDataSource(typeName, object, alertsAttr string, list func(c *client.Client, ctx context.Context) ([]client.Integration, error)) datasource.DataSource {
+	return &apiLookupDataSource[client.Integration, integrationModel]{
+		typeName:    typeName,
+		description: integrationDescription(object, alertsAttr),
+		extra:       integrationAttributes(object, alertsAttr),
+		list:        list,
+		lookup:      integrationLookup(object),
+		model: func(ctx context.Context, integration client.Integration, diags *diag.Diagnostics) integrationModel {
+			return integrationModelFromAPI(integration)
+		},
+	}
+}
+
+func integrationDescription(object, alertsAttr string) string {
+	return fmt.Sprintf("Looks up a single %s by `id` or `name`, for use in the `%s` of checks and heartbeats.", object, alertsAttr)
+}
+
+func integrationAttributes(object, alertsAttr string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: fmt.Sprintf("ID of the %s, as used in %s", object, alertsAttr),
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: fmt.Sprintf("Name of the %s", object),
+			Computed:    true,
+		},
+	}
+}
+
+func integrationLookup(object string) objectLookup[client.Integration] {
+	return objectLookup[client.Integration]{
+		object:   object,
+		id:       func(integration client.Integration) string { return integration.ID },
+		describe: func(integration client.Integration) string { return integration.Name },
+		fields: map[string]func(client.Integration) string{
+			"id":   func(integration client.Integration) string { return integration.ID },
+			"name": func(integration client.Integration) string { return integration.Name },
+		},
+	}
+}
+
+func integrationModelFromAPI(integration client.Integration) integrationModel {
+	return integrationModel{
+		Id:   types.StringValue(integration.ID),
+		Name: types.StringValue(integration.Name),
+	}
+}
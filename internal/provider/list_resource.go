@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+// apiListResource implements list.ListResource for objects returned by one of
+// the client List* calls. T is the API object and C the list block config
+// model holding the filter arguments.
+type apiListResource[T, C any] struct {
+	client *client.Client
+
+	// typeName is the managed resource type suffix, e.g. "heartbeat"
+	typeName string
+	// object is the human readable object name used in diagnostics
+	object string
+	// schema describes the filter arguments of the list block
+	schema listschema.Schema
+
+	list        func(c *client.Client, ctx context.Context) ([]T, error)
+	match       func(config C, item T) bool
+	id          func(T) string
+	displayName func(T) string
+	// model converts an API object to the managed resource model
+	model func(ctx context.Context, item T, diags *diag.Diagnostics) any
+}
+
+func (l *apiListResource[T, C]) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + l.typeName
+}
+
+func (l *apiListResource[T, C]) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = l.schema
+}
+
+func (l *apiListResource[T, C]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	l.client = c
+}
+
+func (l *apiListResource[T, C]) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config C
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := l.list(l.client, ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list %ss, got error: %s", l.object, err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, item := range items {
+			if !l.match(config, item) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = l.displayName(item)
+			result.Diagnostics.Append(setIDIdentity(ctx, result.Identity, l.id(item))...)
+
+			if req.IncludeResource {
+				model := l.model(ctx, item, &result.Diagnostics)
+				result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// namePrefixAttribute returns the name_prefix filter shared by list blocks
+func namePrefixAttribute(object string) listschema.StringAttribute {
+	return listschema.StringAttribute{
+		Optional:    true,
+		Description: fmt.Sprintf("Only return %ss whose name starts with this prefix.", object),
+	}
+}
+
+// statusAttribute returns the status filter shared by monitor list blocks
+func statusAttribute(object string) listschema.StringAttribute {
+	return listschema.StringAttribute{
+		Optional:    true,
+		Description: fmt.Sprintf("Only return %ss currently in this status, e.g. `UP` or `DOWN`. Case-insensitive.", object),
+	}
+}
+
+// matchesPrefix reports whether value starts with prefix. A null prefix
+// matches everything.
+func matchesPrefix(prefix types.String, value string) bool {
+	return prefix.IsNull() || strings.HasPrefix(value, prefix.ValueString())
+}
+
+// matchesFold reports whether value equals want ignoring case. A null want
+// matches everything.
+func matchesFold(want types.String, value string) bool {
+	return want.IsNull() || strings.EqualFold(want.ValueString(), value)
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+// testListResource configures l against a server returning items for every
+// list call and runs it with the given filter config.
+func testListResource(t *testing.T, l list.ListResource, r resource.ResourceWithIdentity, items any, config map[string]tftypes.Value) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"result": items, "success": true})
+	}))
+	t.Cleanup(server.Close)
+
+	var configureResp resource.ConfigureResponse
+	l.(list.ListResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{
+		ProviderData: client.NewClient(&client.Config{APIKey: "test", BaseURL: server.URL}),
+	}, &configureResp)
+
+	var listSchemaResp list.ListResourceSchemaResponse
+	l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &listSchemaResp)
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identitySchemaResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	configType := listSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attrType := range configType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range config {
+		values[name] = value
+	}
+
+	var stream list.ListResultsStream
+	l.List(ctx, list.ListRequest{
+		Config:                 tfsdk.Config{Schema: listSchemaResp.Schema, Raw: tftypes.NewValue(configType, values)},
+		IncludeResource:        true,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+	}, &stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func TestHeartbeatListResource(t *testing.T) {
+	heartbeats := []client.Heartbeat{
+		{ID: "hb1", Name: "backup-nightly", GracePeriod: 60, ReportPeriod: 3600, Status: "UP", PingURL: "https://ping.example.com/hb1", UserAlerts: []string{"u1"}},
+		{ID: "hb2", Name: "backup-weekly", GracePeriod: 60, ReportPeriodCron: "0 0 * * 0", Status: "DOWN"},
+		{ID: "hb3", Name: "billing", GracePeriod: 30, Status: "UP"},
+	}
+
+	results := testListResource(t, NewHeartbeatListResource(), &HeartbeatResource{}, heartbeats, map[string]tftypes.Value{
+		"name_prefix": tftypes.NewValue(tftypes.String, "backup-"),
+		"status":      tftypes.NewValue(tftypes.String, "up"),
+	})
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if results[0].DisplayName != "backup-nightly" {
+		t.Errorf("DisplayName = %q, want backup-nightly", results[0].DisplayName)
+	}
+
+	var identity idIdentityModel
+	results[0].Identity.Get(context.Background(), &identity)
+	if identity.Id.ValueString() != "hb1" {
+		t.Errorf("identity id = %s, want hb1", identity.Id)
+	}
+
+	var data heartbeatResourceModel
+	if diags := results[0].Resource.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("reading resource: %v", diags)
+	}
+	if data.ReportPeriod.ValueInt64() != 3600 || !data.ReportPeriodCron.IsNull() {
+		t.Errorf("unexpected report period %s / %s", data.ReportPeriod, data.ReportPeriodCron)
+	}
+	if data.PingUrl.ValueString() != "https://ping.example.com/hb1" {
+		t.Errorf("ping_url = %s, want https://ping.example.com/hb1", data.PingUrl)
+	}
+	var users []string
+	data.UserAlerts.ElementsAs(context.Background(), &users, false)
+	if len(users) != 1 || users[0] != "u1" {
+		t.Errorf("user_alerts = %v, want [u1]", users)
+	}
+}
+
+func TestDNSCheckListResource(t *testing.T) {
+	checks := []client.DNSCheck{
+		{ID: "c1", Name: "apex", CheckType: "DNS", DNSDomain: "example.com", DNSRecordType: "A"},
+		{ID: "c2", Name: "mail", CheckType: "DNS", DNSDomain: "example.com", DNSRecordType: "MX"},
+		{ID: "c3", Name: "api", CheckType: "UPTIME"},
+	}
+
+	results := testListResource(t, NewDNSCheckListResource(), &DNSCheckResource{}, checks, map[string]tftypes.Value{
+		"record_type": tftypes.NewValue(tftypes.String, "mx"),
+	})
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+
+	var data DNSCheckModel
+	if diags := results[0].Resource.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("reading resource: %v", diags)
+	}
+	if data.Id != types.StringValue("c2") || data.DNSDomain != types.StringValue("example.com") {
+		t.Errorf("unexpected resource %s / %s", data.Id, data.DNSDomain)
+	}
+}
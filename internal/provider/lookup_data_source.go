@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+// apiLookupDataSource implements a data source that reads a single object
+// returned by one of the client List* calls. The object is selected by
+// exactly one of the lookup attributes, and every attribute of the matching
+// managed resource is exposed. T is the API object and M the data source
+// model.
+type apiLookupDataSource[T, M any] struct {
+	client *client.Client
+
+	// typeName is the data source type suffix, e.g. "heartbeat"
+	typeName    string
+	description string
+
+	// resource returns the managed resource whose attributes are exposed.
+	// Objects that are not managed by this provider leave it nil and
+	// declare every attribute in extra.
+	resource func() resource.Resource
+	// exclude lists resource-only attributes that the data source omits
+	exclude []string
+	// extra holds attributes that replace or add to the resource attributes
+	extra map[string]schema.Attribute
+
+	// list returns every object that can be looked up
+	list func(c *client.Client, ctx context.Context) ([]T, error)
+	// lookup describes how the lookup attributes match API objects. Each
+	// key of its fields is an attribute that can be set to select an object.
+	lookup objectLookup[T]
+	// model converts an API object to the data source model
+	model func(ctx context.Context, item T, diags *diag.Diagnostics) M
+}
+
+func (d *apiLookupDataSource[T, M]) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.typeName
+}
+
+func (d *apiLookupDataSource[T, M]) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{}
+	if d.resource != nil {
+		attrs = computedAttributes(resourceSchema(ctx, d.resource()).Attributes, d.exclude...)
+	}
+	for name, attr := range d.extra {
+		attrs[name] = attr
+	}
+	for _, key := range d.lookupKeys() {
+		attr := attrs[key].(schema.StringAttribute)
+		attr.Optional = true
+		attrs[key] = attr
+	}
+
+	resp.Schema = schema.Schema{
+		Description: d.description,
+		Attributes:  attrs,
+	}
+}
+
+func (d *apiLookupDataSource[T, M]) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	keys := d.lookupKeys()
+	expressions := make([]path.Expression, len(keys))
+	for i, key := range keys {
+		expressions[i] = path.MatchRoot(key)
+	}
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(expressions...),
+	}
+}
+
+func (d *apiLookupDataSource[T, M]) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *apiLookupDataSource[T, M]) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	lookup := d.lookup
+
+	var key, value string
+	for _, k := range d.lookupKeys() {
+		var v types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(k), &v)...)
+		if !v.IsNull() && !v.IsUnknown() {
+			key, value = k, v.ValueString()
+			break
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if key == "" {
+		resp.Diagnostics.AddError(
+			"Missing Required Attribute",
+			fmt.Sprintf("One of %s must be set to look up a %s.", strings.Join(d.lookupKeys(), ", "), lookup.object),
+		)
+		return
+	}
+
+	items, err := d.list(d.client, ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %ss, got error: %s", lookup.object, err))
+		return
+	}
+
+	matches := lookup.matches(items, key, value)
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("%s Not Found", titleCase(lookup.object)),
+			fmt.Sprintf("No %s found with %s %q.", lookup.object, key, value),
+		)
+		return
+	case 1:
+	default:
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Multiple %ss Found", titleCase(lookup.object)),
+			fmt.Sprintf("%d %ss match %s %q. Look one of them up by id instead:\n%s", len(matches), lookup.object, key, value, lookup.candidates(matches)),
+		)
+		return
+	}
+
+	data := d.model(ctx, matches[0], &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// lookupKeys returns the lookup attributes with id first and the rest sorted
+func (d *apiLookupDataSource[T, M]) lookupKeys() []string {
+	keys := []string{"id"}
+	for key := range d.lookup.fields {
+		if key != "id" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys[1:])
+	return keys
+}
+
+// titleCase capitalises each word of a human readable object name
+func titleCase(s string) string {
+	words := strings.Fields(s)
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+	return strings.Join(words, " ")
+}
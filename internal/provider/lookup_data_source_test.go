@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+// testReadDataSource reads typeName with the given string attributes set and
+// every other attribute null
+func testReadDataSource(t *testing.T, server tfprotov6.ProviderServer, typeName string, config map[string]string) (*tfprotov6.ReadDataSourceResponse, map[string]tftypes.Value) {
+	t.Helper()
+
+	values := map[string]tftypes.Value{}
+	for name, value := range config {
+		values[name] = tftypes.NewValue(tftypes.String, value)
+	}
+	return testReadDataSourceValues(t, server, typeName, values)
+}
+
+// testReadDataSourceValues is testReadDataSource for configs with arguments
+// that are not strings
+func testReadDataSourceValues(t *testing.T, server tfprotov6.ProviderServer, typeName string, config map[string]tftypes.Value) (*tfprotov6.ReadDataSourceResponse, map[string]tftypes.Value) {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp, _ := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	configType := schemaResp.DataSourceSchemas[typeName].ValueType().(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attrType := range configType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range config {
+		values[name] = value
+	}
+
+	dv, err := tfprotov6.NewDynamicValue(configType, tftypes.NewValue(configType, values))
+	if err != nil {
+		t.Fatalf("encoding config: %s", err)
+	}
+	resp, _ := server.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{TypeName: typeName, Config: &dv})
+	if resp.State == nil {
+		return resp, nil
+	}
+
+	state, err := resp.State.Unmarshal(configType)
+	if err != nil {
+		t.Fatalf("decoding state: %s", err)
+	}
+	var attrs map[string]tftypes.Value
+	state.As(&attrs)
+	return resp, attrs
+}
+
+func TestStatusPageDataSource(t *testing.T) {
+	pages := []client.StatusPage{
+		{ID: "sp1", Name: "Acme", Subdomain: "acme", AllowedIPs: []string{"10.0.0.0/8"}},
+		{ID: "sp2", Name: "Shared", Subdomain: "shared-a"},
+		{ID: "sp3", Name: "Shared", Subdomain: "shared-b"},
+	}
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"result": pages, "success": true})
+	}))
+	defer api.Close()
+
+	server := testProviderServer(t, api.URL)
+
+	t.Run("by subdomain", func(t *testing.T) {
+		resp, attrs := testReadDataSource(t, server, "onlineornot_status_page", map[string]string{"subdomain": "acme"})
+		for _, d := range resp.Diagnostics {
+			t.Fatalf("read: %s: %s", d.Summary, d.Detail)
+		}
+		var id, name string
+		attrs["id"].As(&id)
+		attrs["name"].As(&name)
+		if id != "sp1" || name != "Acme" || attrs["allowed_ips"].IsNull() {
+			t.Errorf("unexpected state %v", attrs)
+		}
+	})
+
+	for name, config := range map[string]map[string]string{
+		"not found": {"id": "missing"},
+		"ambiguous": {"name": "Shared"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, _ := testReadDataSource(t, server, "onlineornot_status_page", config)
+			if len(resp.Diagnostics) == 0 || resp.Diagnostics[0].Severity != tfprotov6.DiagnosticSeverityError {
+				t.Fatalf("expected an error, got %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestIntegrationDataSources(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var result []client.Integration
+		switch req.URL.Path {
+		case "/v1/integrations/slack":
+			result = []client.Integration{{ID: "sl1", Name: "#alerts"}, {ID: "sl2", Name: "#deploys"}}
+		case "/v1/integrations/oncall":
+			result = []client.Integration{{ID: "oc1", Name: "Primary", Provider: "PAGERDUTY"}}
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"result": result, "success": true})
+	}))
+	defer api.Close()
+
+	server := testProviderServer(t, api.URL)
+
+	t.Run("slack channel by name", func(t *testing.T) {
+		resp, attrs := testReadDataSource(t, server, "onlineornot_slack_channel", map[string]string{"name": "#deploys"})
+		for _, d := range resp.Diagnostics {
+			t.Fatalf("read: %s: %s", d.Summary, d.Detail)
+		}
+		var id string
+		attrs["id"].As(&id)
+		if id != "sl2" {
+			t.Errorf("id = %q, want sl2", id)
+		}
+	})
+
+	t.Run("on-call schedule service", func(t *testing.T) {
+		resp, attrs := testReadDataSource(t, server, "onlineornot_oncall_schedule", map[string]string{"name": "Primary"})
+		for _, d := range resp.Diagnostics {
+			t.Fatalf("read: %s: %s", d.Summary, d.Detail)
+		}
+		var service string
+		attrs["service"].As(&service)
+		if service != "PAGERDUTY" {
+			t.Errorf("service = %q, want PAGERDUTY", service)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		resp, _ := testReadDataSource(t, server, "onlineornot_slack_channel", map[string]string{"name": "#missing"})
+		if len(resp.Diagnostics) == 0 || resp.Diagnostics[0].Summary != "Slack Channel Not Found" {
+			t.Fatalf("expected a not found error, got %v", resp.Diagnostics)
+		}
+	})
+}
+
+func TestCheckDataSource(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		result := []any{
+			client.Check{ID: "c1", Name: "api", URL: "https://api.example.com", CheckType: "UPTIME"},
+			map[string]any{"id": "c2", "name": "api", "check_type": "DNS", "dns_domain": "api.example.com", "dns_record_type": "A"},
+			map[string]any{"id": "c3", "name": "db", "check_type": "TCP", "tcp_hostname": "db.example.com", "tcp_port": 5432},
+		}
+		json.NewEncoder(w).Encode(map[string]any{"result": result, "success": true})
+	}))
+	defer api.Close()
+
+	server := testProviderServer(t, api.URL)
+
+	// The DNS check of the same name is not a candidate
+	resp, attrs := testReadDataSource(t, server, "onlineornot_check", map[string]string{"name": "api"})
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("read: %s: %s", d.Summary, d.Detail)
+	}
+	var id string
+	attrs["id"].As(&id)
+	if id != "c1" {
+		t.Errorf("id = %q, want c1", id)
+	}
+
+	resp, _ = testReadDataSource(t, server, "onlineornot_check", map[string]string{"id": "c3"})
+	if len(resp.Diagnostics) == 0 || resp.Diagnostics[0].Summary != "Check Not Found" {
+		t.Errorf("expected a TCP check not to be found, got %v", resp.Diagnostics)
+	}
+}
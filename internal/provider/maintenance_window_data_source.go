@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/provider/resource_maintenance_window"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &apiLookupDataSource[client.MaintenanceWindow, resource_maintenance_window.MaintenanceWindowModel]{}
+
+func NewMaintenanceWindowDataSource() datasource.DataSource {
+	return &apiLookupDataSource[client.MaintenanceWindow, resource_maintenance_window.MaintenanceWindowModel]{
+		typeName:    "maintenance_window",
+		description: "Looks up a single maintenance window by `id` or `name`.",
+		resource:    NewMaintenanceWindowResource,
+		list:        (*client.Client).ListMaintenanceWindows,
+		lookup: objectLookup[client.MaintenanceWindow]{
+			object: "maintenance window",
+			id:     func(mw client.MaintenanceWindow) string { return mw.ID },
+			describe: func(mw client.MaintenanceWindow) string {
+				return fmt.Sprintf("%s, %s %s", mw.Name, mw.StartDate, mw.Timezone)
+			},
+			fields: map[string]func(client.MaintenanceWindow) string{
+				"id":   func(mw client.MaintenanceWindow) string { return mw.ID },
+				"name": func(mw client.MaintenanceWindow) string { return mw.Name },
+			},
+		},
+		model: func(ctx context.Context, mw client.MaintenanceWindow, diags *diag.Diagnostics) resource_maintenance_window.MaintenanceWindowModel {
+			return maintenanceWindowModelFromAPI(ctx, &mw, diags)
+		},
+	}
+}
+
+// maintenanceWindowModelFromAPI builds a complete resource model from an API
+// maintenance window
+func maintenanceWindowModelFromAPI(ctx context.Context, mw *client.MaintenanceWindow, diags *diag.Diagnostics) resource_maintenance_window.MaintenanceWindowModel {
+	return resource_maintenance_window.MaintenanceWindowModel{
+		Id:              types.StringValue(mw.ID),
+		Name:            types.StringValue(mw.Name),
+		StartDate:       types.StringValue(mw.StartDate),
+		DurationMinutes: types.Int64Value(int64(mw.DurationMinutes)),
+		DaysOfWeek:      stringListValue(ctx, mw.DaysOfWeek, diags),
+		Timezone:        types.StringValue(mw.Timezone),
+		Checks:          stringListValue(ctx, mw.Checks, diags),
+		Heartbeats:      stringListValue(ctx, mw.Heartbeats, diags),
+	}
+}
@@ -14,6 +14,9 @@ import (
 
 var _ resource.Resource = &MaintenanceWindowResource{}
 var _ resource.ResourceWithImportState = &MaintenanceWindowResource{}
+var _ resource.ResourceWithIdentity = &MaintenanceWindowResource{}
+
+const maintenanceWindowSchemaVersion = 0
 
 func NewMaintenanceWindowResource() resource.Resource {
 	return &MaintenanceWindowResource{}
@@ -29,6 +32,11 @@ func (r *MaintenanceWindowResource) Metadata(ctx context.Context, req resource.M
 
 func (r *MaintenanceWindowResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_maintenance_window.MaintenanceWindowResourceSchema(ctx)
+	resp.Schema.Version = maintenanceWindowSchemaVersion
+}
+
+func (r *MaintenanceWindowResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Maintenance Window")
 }
 
 func (r *MaintenanceWindowResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -73,7 +81,7 @@ func (r *MaintenanceWindowResource) Create(ctx context.Context, req resource.Cre
 		data.Heartbeats.ElementsAs(ctx, &mw.Heartbeats, false)
 	}
 
-	created, err := r.client.CreateMaintenanceWindow(mw)
+	created, err := r.client.CreateMaintenanceWindow(ctx, mw)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create maintenance window, got error: %s", err))
 		return
@@ -90,6 +98,7 @@ func (r *MaintenanceWindowResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *MaintenanceWindowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -100,7 +109,7 @@ func (r *MaintenanceWindowResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	mw, err := r.client.GetMaintenanceWindow(data.Id.ValueString())
+	mw, err := r.client.GetMaintenanceWindow(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read maintenance window, got error: %s", err))
 		return
@@ -113,6 +122,7 @@ func (r *MaintenanceWindowResource) Read(ctx context.Context, req resource.ReadR
 	data.Timezone = types.StringValue(mw.Timezone)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *MaintenanceWindowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -140,13 +150,14 @@ func (r *MaintenanceWindowResource) Update(ctx context.Context, req resource.Upd
 		data.Heartbeats.ElementsAs(ctx, &mw.Heartbeats, false)
 	}
 
-	_, err := r.client.UpdateMaintenanceWindow(data.Id.ValueString(), mw)
+	_, err := r.client.UpdateMaintenanceWindow(ctx, data.Id.ValueString(), mw)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update maintenance window, got error: %s", err))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *MaintenanceWindowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -157,7 +168,7 @@ func (r *MaintenanceWindowResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	err := r.client.DeleteMaintenanceWindow(data.Id.ValueString())
+	err := r.client.DeleteMaintenanceWindow(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete maintenance window, got error: %s", err))
 		return
@@ -165,5 +176,5 @@ func (r *MaintenanceWindowResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *MaintenanceWindowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+// monitorStatusModel holds the pause state shared by checks and heartbeats
+type monitorStatusModel struct {
+	Paused types.Bool   `tfsdk:"paused"`
+	Status types.String `tfsdk:"status"`
+}
+
+// setStatus records the status reported by the API
+func (m *monitorStatusModel) setStatus(status string) {
+	m.Status = optionalStringValue(status)
+	m.Paused = types.BoolValue(status == client.StatusPaused)
+}
+
+// keepPlannedStatus restores the status planned for an update when it was
+// known. Terraform rejects an apply that changes a known planned value, so a
+// status change reported by the API during the update waits for the next
+// refresh.
+func (m *monitorStatusModel) keepPlannedStatus(planned types.String) {
+	if !planned.IsUnknown() {
+		m.Status = planned
+	}
+}
+
+// addMonitorStatusAttributes adds paused and status to a monitor schema
+func addMonitorStatusAttributes(attributes map[string]schema.Attribute, object string) {
+	attributes["paused"] = schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         fmt.Sprintf("Whether the %s is paused. Pausing or resuming in the dashboard is reverted on the next apply. Defaults to false.", object),
+		MarkdownDescription: fmt.Sprintf("Whether the %s is paused. Pausing or resuming in the dashboard is reverted on the next apply. Defaults to `false`.", object),
+		Default:             booldefault.StaticBool(false),
+	}
+	attributes["status"] = schema.StringAttribute{
+		Computed:            true,
+		Description:         fmt.Sprintf("Current status of the %s (UP, DOWN, PENDING, PAUSED, MUTED, MAINTENANCE, RECOVERING, VERIFYING)", object),
+		MarkdownDescription: fmt.Sprintf("Current status of the %s (UP, DOWN, PENDING, PAUSED, MUTED, MAINTENANCE, RECOVERING, VERIFYING)", object),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			statusFollowsPausedModifier{},
+		},
+	}
+}
+
+// statusFollowsPausedModifier leaves status unknown when the plan pauses or
+// resumes the monitor, as its status then changes on apply
+type statusFollowsPausedModifier struct{}
+
+func (m statusFollowsPausedModifier) Description(ctx context.Context) string {
+	return "The value is unknown when paused changes."
+}
+
+func (m statusFollowsPausedModifier) MarkdownDescription(ctx context.Context) string {
+	return "The value is unknown when `paused` changes."
+}
+
+func (m statusFollowsPausedModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planned, prior types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("paused"), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("paused"), &prior)...)
+	if !planned.Equal(prior) {
+		resp.PlanValue = types.StringUnknown()
+	}
+}
+
+// pausedDataSourceAttribute describes paused on monitor data sources, where
+// it only reports the pause state
+func pausedDataSourceAttribute(object string) dsschema.BoolAttribute {
+	return dsschema.BoolAttribute{
+		Computed:    true,
+		Description: fmt.Sprintf("Whether the %s is paused", object),
+	}
+}
+
+// lastQueuedAttribute describes the computed last_queued attribute of checks
+func lastQueuedAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:            true,
+		Description:         "Last time the check was queued",
+		MarkdownDescription: "Last time the check was queued",
+	}
+}
+
+// applyPaused pauses or resumes the monitor id when status does not match
+// the planned paused value and returns the resulting status.
+func applyPaused(ctx context.Context, paused types.Bool, id, status string, pause, resume func(ctx context.Context, id string) (*client.MonitorStatus, error)) (string, error) {
+	switch {
+	case paused.ValueBool() && status != client.StatusPaused:
+		result, err := pause(ctx, id)
+		if err != nil {
+			return status, fmt.Errorf("pausing: %w", err)
+		}
+		return result.Status, nil
+	case !paused.IsNull() && !paused.ValueBool() && status == client.StatusPaused:
+		result, err := resume(ctx, id)
+		if err != nil {
+			return status, fmt.Errorf("resuming: %w", err)
+		}
+		return result.Status, nil
+	}
+	return status, nil
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+func TestApplyPaused(t *testing.T) {
+	cases := map[string]struct {
+		paused     types.Bool
+		status     string
+		wantCall   string
+		wantStatus string
+	}{
+		"pause running":    {types.BoolValue(true), "UP", "pause", client.StatusPaused},
+		"already paused":   {types.BoolValue(true), client.StatusPaused, "", client.StatusPaused},
+		"resume paused":    {types.BoolValue(false), client.StatusPaused, "resume", "PENDING"},
+		"already running":  {types.BoolValue(false), "DOWN", "", "DOWN"},
+		"unmanaged paused": {types.BoolNull(), client.StatusPaused, "", client.StatusPaused},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var call string
+			pause := func(ctx context.Context, id string) (*client.MonitorStatus, error) {
+				call = "pause"
+				return &client.MonitorStatus{ID: id, Status: client.StatusPaused}, nil
+			}
+			resume := func(ctx context.Context, id string) (*client.MonitorStatus, error) {
+				call = "resume"
+				return &client.MonitorStatus{ID: id, Status: "PENDING"}, nil
+			}
+
+			status, err := applyPaused(context.Background(), tc.paused, "c1", tc.status, pause, resume)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if call != tc.wantCall {
+				t.Errorf("called %q, want %q", call, tc.wantCall)
+			}
+			if status != tc.wantStatus {
+				t.Errorf("status = %s, want %s", status, tc.wantStatus)
+			}
+		})
+	}
+}
+
+func TestStatusPlanModifiers(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	NewHeartbeatResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	// monitor returns a heartbeat object with only paused and status set
+	monitor := func(paused bool, status tftypes.Value) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["paused"] = tftypes.NewValue(tftypes.Bool, paused)
+		values["status"] = status
+		return tftypes.NewValue(objectType, values)
+	}
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	cases := map[string]struct {
+		planPaused bool
+		want       types.String
+	}{
+		"paused unchanged": {false, types.StringValue("UP")},
+		"pausing":          {true, types.StringUnknown()},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				Path:       path.Root("status"),
+				PlanValue:  types.StringUnknown(),
+				StateValue: types.StringValue("UP"),
+				Plan:       tfsdk.Plan{Schema: schemaResp.Schema, Raw: monitor(tc.planPaused, unknown)},
+				State:      tfsdk.State{Schema: schemaResp.Schema, Raw: monitor(false, tftypes.NewValue(tftypes.String, "UP"))},
+			}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+			for _, modifier := range schemaResp.Schema.Attributes["status"].(schema.StringAttribute).PlanModifiers {
+				modifier.PlanModifyString(ctx, req, resp)
+				req.PlanValue = resp.PlanValue
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tc.want) {
+				t.Errorf("planned status = %s, want %s", resp.PlanValue, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+// on_destroy values
+const (
+	onDestroyDelete = "delete"
+	onDestroyPause  = "pause"
+	onDestroyDetach = "detach"
+)
+
+// onDestroyAttribute returns the schema for the on_destroy attribute shared by
+// the check and heartbeat resources.
+func onDestroyAttribute(object string) schema.StringAttribute {
+	description := fmt.Sprintf("What happens to the %s when this resource is destroyed or removed from the configuration: `delete` deletes it along with its history, `pause` pauses it and keeps its history, and `detach` leaves it running and only removes it from the Terraform state. Defaults to `delete`.", object)
+	return schema.StringAttribute{
+		Optional:            true,
+		Description:         description,
+		MarkdownDescription: description,
+		Validators: []validator.String{
+			stringvalidator.OneOf(onDestroyDelete, onDestroyPause, onDestroyDetach),
+		},
+	}
+}
+
+// onDestroyMode returns the configured on_destroy value, defaulting to delete
+func onDestroyMode(onDestroy types.String) string {
+	if onDestroy.IsNull() || onDestroy.IsUnknown() {
+		return onDestroyDelete
+	}
+	return onDestroy.ValueString()
+}
+
+// destroyMonitor deletes, pauses or detaches the monitor id according to
+// onDestroy.
+func destroyMonitor(ctx context.Context, onDestroy types.String, id string, deleteMonitor func(ctx context.Context, id string) error, pause func(ctx context.Context, id string) (*client.MonitorStatus, error)) error {
+	switch onDestroyMode(onDestroy) {
+	case onDestroyPause:
+		_, err := pause(ctx, id)
+		return err
+	case onDestroyDetach:
+		return nil
+	default:
+		return deleteMonitor(ctx, id)
+	}
+}
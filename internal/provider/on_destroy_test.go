@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+func TestDestroyMonitor(t *testing.T) {
+	cases := map[string]struct {
+		onDestroy types.String
+		want      string
+	}{
+		"default": {types.StringNull(), "delete"},
+		"delete":  {types.StringValue(onDestroyDelete), "delete"},
+		"pause":   {types.StringValue(onDestroyPause), "pause"},
+		"detach":  {types.StringValue(onDestroyDetach), ""},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var call string
+			deleteMonitor := func(ctx context.Context, id string) error {
+				call = "delete"
+				return nil
+			}
+			pause := func(ctx context.Context, id string) (*client.MonitorStatus, error) {
+				call = "pause"
+				return &client.MonitorStatus{ID: id, Status: client.StatusPaused}, nil
+			}
+
+			if err := destroyMonitor(context.Background(), tc.onDestroy, "c1", deleteMonitor, pause); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if call != tc.want {
+				t.Errorf("called %q, want %q", call, tc.want)
+			}
+		})
+	}
+}
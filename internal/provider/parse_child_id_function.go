@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseChildIDFunction{}
+
+// parseChildIDAttributeTypes describes the object returned by parse_child_id
+var parseChildIDAttributeTypes = map[string]attr.Type{
+	"status_page_id": types.StringType,
+	"id":             types.StringType,
+}
+
+func NewParseChildIDFunction() function.Function {
+	return &ParseChildIDFunction{}
+}
+
+// ParseChildIDFunction defines the parse_child_id function implementation.
+type ParseChildIDFunction struct{}
+
+func (f *ParseChildIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_child_id"
+}
+
+func (f *ParseChildIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Split a status page child ID",
+		Description: "Splits an ID in the status_page_id/id form used to import status page components, component groups, incidents and scheduled maintenances into its two parts.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The ID in status_page_id/id form.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseChildIDAttributeTypes,
+		},
+	}
+}
+
+func (f *ParseChildIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	statusPageID, id, ok := parseChildID(value)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid ID: expected format status_page_id/id, got: %s", value))
+		return
+	}
+
+	result, diags := types.ObjectValue(parseChildIDAttributeTypes, map[string]attr.Value{
+		"status_page_id": types.StringValue(statusPageID),
+		"id":             types.StringValue(id),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
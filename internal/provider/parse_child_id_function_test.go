@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseChildIDFunction(t *testing.T) {
+	result, err := runFunction(t, NewParseChildIDFunction(), types.StringValue("page1/comp1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := types.ObjectValueMust(parseChildIDAttributeTypes, map[string]attr.Value{
+		"status_page_id": types.StringValue("page1"),
+		"id":             types.StringValue("comp1"),
+	})
+	if !result.Equal(want) {
+		t.Errorf("result = %s, want %s", result, want)
+	}
+
+	for _, id := range []string{"page1", "/comp1", "page1/", "page1/comp1/extra"} {
+		if _, err := runFunction(t, NewParseChildIDFunction(), types.StringValue(id)); err == nil {
+			t.Errorf("expected an error for %q", id)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+// warnOnPlanLimits warns when the planned check would be rejected by the API
+// for exceeding the limits of the account's plan: a test_interval below the
+// plan minimum, or more checks (or browser checks) than the plan allows once
+// the checks this plan creates and destroys are counted. The API remains the
+// source of truth, so nothing is reported when the account cannot be read.
+func warnOnPlanLimits(ctx context.Context, c *client.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, browser bool) {
+	if c == nil {
+		return
+	}
+	if req.Plan.Raw.IsNull() {
+		// A deleted check frees its place for checks created later in the
+		// plan. A paused or detached check stays in the account and keeps
+		// counting against the quota.
+		var onDestroy types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("on_destroy"), &onDestroy)...)
+		if onDestroyMode(onDestroy) != onDestroyDelete {
+			return
+		}
+		c.ReleaseQuota("checks")
+		if browser {
+			c.ReleaseQuota("browser_checks")
+		}
+		return
+	}
+
+	account, err := c.GetAccount(ctx)
+	if err != nil {
+		return
+	}
+
+	var interval types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("test_interval"), &interval)...)
+	minInterval := int64(account.Quotas.MinTestInterval)
+	if !interval.IsNull() && !interval.IsUnknown() && minInterval > 0 && interval.ValueInt64() < minInterval {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("test_interval"),
+			"Test Interval Below Plan Minimum",
+			fmt.Sprintf("test_interval is %d seconds, but the %s plan only allows intervals of %d seconds or more. The API will reject this check.", interval.ValueInt64(), account.Plan, minInterval),
+		)
+	}
+
+	if !req.State.Raw.IsNull() {
+		return
+	}
+	var adopt types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("adopt_existing"), &adopt)...)
+	if adopt.ValueBool() {
+		// An adopted check is already counted in the usage
+		return
+	}
+
+	warnOnQuota(c, resp, account, "checks", "check", account.Quotas.Checks, account.Usage.Checks)
+	if browser {
+		warnOnQuota(c, resp, account, "browser_checks", "browser check", account.Quotas.BrowserChecks, account.Usage.BrowserChecks)
+	}
+}
+
+// warnOnQuota reserves one more object of kind for the current plan and
+// warns when that may take the organisation past quota. A zero quota is
+// unlimited.
+//
+// The count is an upper bound rather than an exact figure: destroys that
+// Terraform plans after this check are not yet subtracted, and during apply
+// the account may be read after some of the plan's creates have landed, so
+// those are counted both in the usage and in the reservations.
+func warnOnQuota(c *client.Client, resp *resource.ModifyPlanResponse, account *client.Account, kind, object string, quota, usage int) {
+	planned := usage + c.ReserveQuota(kind)
+	if quota <= 0 || planned <= quota {
+		return
+	}
+	resp.Diagnostics.AddWarning(
+		fmt.Sprintf("%s Quota May Be Exceeded", titleCase(object)),
+		fmt.Sprintf("This plan may bring the organisation to as many as %d %ss, but the %s plan allows %d. The count is an upper bound, since checks destroyed later in the plan or already created during this apply may be counted. The API will reject %ss beyond the quota.", planned, object, account.Plan, quota, object),
+	)
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+// planLimitsHarness runs warnOnPlanLimits for DNS check plans against an
+// account with a quota of 10 checks, usage checks of which are in use
+type planLimitsHarness struct {
+	t          *testing.T
+	client     *client.Client
+	schema     resource.SchemaResponse
+	objectType tftypes.Object
+}
+
+func newPlanLimitsHarness(t *testing.T, usage int) *planLimitsHarness {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		account := client.Account{
+			Plan:   "Starter",
+			Quotas: client.AccountQuotas{Checks: 10, MinTestInterval: 60},
+			Usage:  client.AccountUsage{Checks: usage},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"result": account, "success": true})
+	}))
+	t.Cleanup(api.Close)
+
+	h := &planLimitsHarness{t: t, client: client.NewClient(&client.Config{APIKey: "test", BaseURL: api.URL})}
+	NewDNSCheckResource().Schema(context.Background(), resource.SchemaRequest{}, &h.schema)
+	h.objectType = h.schema.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	return h
+}
+
+// value returns a check object with values set and every other attribute null
+func (h *planLimitsHarness) value(values map[string]tftypes.Value) tftypes.Value {
+	all := map[string]tftypes.Value{}
+	for name, attrType := range h.objectType.AttributeTypes {
+		all[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range values {
+		all[name] = value
+	}
+	return tftypes.NewValue(h.objectType, all)
+}
+
+// create plans a new check with values and returns the diagnostic summaries
+func (h *planLimitsHarness) create(values map[string]tftypes.Value) []string {
+	return h.modifyPlan(h.value(values), tftypes.NewValue(h.objectType, nil))
+}
+
+// destroy plans the destruction of a check with the state values and returns
+// the diagnostic summaries
+func (h *planLimitsHarness) destroy(values map[string]tftypes.Value) []string {
+	return h.modifyPlan(tftypes.NewValue(h.objectType, nil), h.value(values))
+}
+
+func (h *planLimitsHarness) modifyPlan(plan, state tftypes.Value) []string {
+	req := resource.ModifyPlanRequest{
+		Plan:  tfsdk.Plan{Schema: h.schema.Schema, Raw: plan},
+		State: tfsdk.State{Schema: h.schema.Schema, Raw: state},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	warnOnPlanLimits(context.Background(), h.client, req, resp, false)
+	var summaries []string
+	for _, d := range resp.Diagnostics {
+		summaries = append(summaries, d.Summary())
+	}
+	return summaries
+}
+
+func TestWarnOnPlanLimits(t *testing.T) {
+	h := newPlanLimitsHarness(t, 9)
+
+	if got := h.create(map[string]tftypes.Value{"test_interval": tftypes.NewValue(tftypes.Number, 60)}); len(got) != 0 {
+		t.Errorf("expected the first check to fit the quota, got %v", got)
+	}
+
+	if got := h.destroy(map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "chk1")}); len(got) != 0 {
+		t.Errorf("expected no warnings for a destroyed check, got %v", got)
+	}
+
+	got := h.create(map[string]tftypes.Value{"test_interval": tftypes.NewValue(tftypes.Number, 30)})
+	if len(got) != 1 || got[0] != "Test Interval Below Plan Minimum" {
+		t.Errorf("expected the destroyed check to free a place for the second check, got %v", got)
+	}
+
+	got = h.create(map[string]tftypes.Value{"test_interval": tftypes.NewValue(tftypes.Number, 60)})
+	if len(got) != 1 || got[0] != "Check Quota May Be Exceeded" {
+		t.Errorf("expected a quota warning for the third check, got %v", got)
+	}
+
+	if got := h.create(map[string]tftypes.Value{"adopt_existing": tftypes.NewValue(tftypes.Bool, true)}); len(got) != 0 {
+		t.Errorf("expected no quota warning for an adopted check, got %v", got)
+	}
+}
+
+func TestWarnOnPlanLimits_OnDestroy(t *testing.T) {
+	tests := []struct {
+		name      string
+		onDestroy tftypes.Value
+		wantFree  bool
+	}{
+		{name: "default", onDestroy: tftypes.NewValue(tftypes.String, nil), wantFree: true},
+		{name: onDestroyDelete, onDestroy: tftypes.NewValue(tftypes.String, onDestroyDelete), wantFree: true},
+		{name: onDestroyPause, onDestroy: tftypes.NewValue(tftypes.String, onDestroyPause), wantFree: false},
+		{name: onDestroyDetach, onDestroy: tftypes.NewValue(tftypes.String, onDestroyDetach), wantFree: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The account is at quota, so the new check only fits when the
+			// destroyed one leaves it
+			h := newPlanLimitsHarness(t, 10)
+
+			h.destroy(map[string]tftypes.Value{
+				"id":         tftypes.NewValue(tftypes.String, "chk1"),
+				"on_destroy": tt.onDestroy,
+			})
+			got := h.create(map[string]tftypes.Value{"test_interval": tftypes.NewValue(tftypes.Number, 60)})
+			if tt.wantFree && len(got) != 0 {
+				t.Errorf("expected the deleted check to free a place, got %v", got)
+			}
+			if !tt.wantFree && (len(got) != 1 || got[0] != "Check Quota May Be Exceeded") {
+				t.Errorf("expected the kept check to still count against the quota, got %v", got)
+			}
+		})
+	}
+}
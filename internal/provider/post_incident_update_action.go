@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+var _ action.Action = &PostIncidentUpdateAction{}
+var _ action.ActionWithConfigure = &PostIncidentUpdateAction{}
+
+func NewPostIncidentUpdateAction() action.Action {
+	return &PostIncidentUpdateAction{}
+}
+
+// PostIncidentUpdateAction defines the action implementation.
+type PostIncidentUpdateAction struct {
+	client *client.Client
+}
+
+// PostIncidentUpdateActionModel describes the action data model.
+type PostIncidentUpdateActionModel struct {
+	StatusPageId      types.String `tfsdk:"status_page_id"`
+	IncidentId        types.String `tfsdk:"incident_id"`
+	Status            types.String `tfsdk:"status"`
+	Message           types.String `tfsdk:"message"`
+	NotifySubscribers types.Bool   `tfsdk:"notify_subscribers"`
+}
+
+func (a *PostIncidentUpdateAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_post_incident_update"
+}
+
+func (a *PostIncidentUpdateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Appends an update to a status page incident.",
+		Attributes: map[string]schema.Attribute{
+			"status_page_id": schema.StringAttribute{
+				Description: "Status Page ID",
+				Required:    true,
+			},
+			"incident_id": schema.StringAttribute{
+				Description: "Status Page Incident ID",
+				Required:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the incident after this update",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"INVESTIGATING",
+						"IDENTIFIED",
+						"MONITORING",
+						"RESOLVED",
+						"UPDATE",
+					),
+				},
+			},
+			"message": schema.StringAttribute{
+				Description: "The text of the update",
+				Required:    true,
+			},
+			"notify_subscribers": schema.BoolAttribute{
+				Description: "Whether to notify status page subscribers",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *PostIncidentUpdateAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = c
+}
+
+func (a *PostIncidentUpdateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data PostIncidentUpdateActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	update := &client.StatusPageIncidentUpdate{
+		Status:      data.Status.ValueString(),
+		Description: data.Message.ValueString(),
+	}
+	if !data.NotifySubscribers.IsNull() {
+		update.NotifySubscribers = data.NotifySubscribers.ValueBoolPointer()
+	}
+
+	created, err := a.client.CreateStatusPageIncidentUpdate(ctx, data.StatusPageId.ValueString(), data.IncidentId.ValueString(), update)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to post incident update, got error: %s", err))
+		return
+	}
+
+	sendProgress(resp, fmt.Sprintf("Posted update %s to incident %s", created.ID, data.IncidentId.ValueString()))
+}
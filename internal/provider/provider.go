@@ -2,12 +2,20 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
@@ -15,6 +23,10 @@ import (
 
 // Ensure OnlineornotProvider satisfies various provider interfaces.
 var _ provider.Provider = &OnlineornotProvider{}
+var _ provider.ProviderWithListResources = &OnlineornotProvider{}
+var _ provider.ProviderWithFunctions = &OnlineornotProvider{}
+var _ provider.ProviderWithEphemeralResources = &OnlineornotProvider{}
+var _ provider.ProviderWithActions = &OnlineornotProvider{}
 
 // OnlineornotProvider defines the provider implementation.
 type OnlineornotProvider struct {
@@ -26,8 +38,11 @@ type OnlineornotProvider struct {
 
 // OnlineornotProviderModel describes the provider data model.
 type OnlineornotProviderModel struct {
-	APIKey  types.String `tfsdk:"api_key"`
-	BaseURL types.String `tfsdk:"base_url"`
+	APIKey                types.String  `tfsdk:"api_key"`
+	BaseURL               types.String  `tfsdk:"base_url"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	ReadCache             types.Bool    `tfsdk:"read_cache"`
 }
 
 func (p *OnlineornotProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -48,6 +63,24 @@ func (p *OnlineornotProvider) Schema(ctx context.Context, req provider.SchemaReq
 				Description: "The base URL for the OnlineOrNot API. Defaults to https://api.onlineornot.com.",
 				Optional:    true,
 			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: fmt.Sprintf("Maximum sustained number of API requests per second, shared by all resources and data sources of this provider instance. Defaults to %d. Set to 0 to disable rate limiting.", client.DefaultRequestsPerSecond),
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of API requests in flight at once, shared by all resources and data sources of this provider instance. Defaults to %d. Set to 0 to disable the limit.", client.DefaultMaxConcurrentRequests),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"read_cache": schema.BoolAttribute{
+				Description: "Share list requests between data sources, lookups and imports. The first list of each object type is fetched once and reused, and any change made by this provider instance clears the cached list. Refreshes of individual resources always read the object directly. Useful for large configurations. Defaults to false.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -78,14 +111,37 @@ func (p *OnlineornotProvider) Configure(ctx context.Context, req provider.Config
 	// Get base URL from config or use default
 	baseURL := data.BaseURL.ValueString()
 
+	// Zero disables throttling in the provider schema, whereas the client
+	// treats zero as "use the default" and a negative value as disabled.
+	requestsPerSecond := 0.0
+	if !data.RequestsPerSecond.IsNull() {
+		requestsPerSecond = data.RequestsPerSecond.ValueFloat64()
+		if requestsPerSecond == 0 {
+			requestsPerSecond = -1
+		}
+	}
+	maxConcurrentRequests := 0
+	if !data.MaxConcurrentRequests.IsNull() {
+		maxConcurrentRequests = int(data.MaxConcurrentRequests.ValueInt64())
+		if maxConcurrentRequests == 0 {
+			maxConcurrentRequests = -1
+		}
+	}
+
 	// Create client
 	c := client.NewClient(&client.Config{
-		APIKey:  apiKey,
-		BaseURL: baseURL,
+		APIKey:                apiKey,
+		BaseURL:               baseURL,
+		RequestsPerSecond:     requestsPerSecond,
+		MaxConcurrentRequests: maxConcurrentRequests,
+		ReadCache:             data.ReadCache.ValueBool(),
 	})
 
 	resp.DataSourceData = c
 	resp.ResourceData = c
+	resp.ListResourceData = c
+	resp.EphemeralResourceData = c
+	resp.ActionData = c
 }
 
 func (p *OnlineornotProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -115,6 +171,60 @@ func (p *OnlineornotProvider) DataSources(ctx context.Context) []func() datasour
 		NewStatusPagesDataSource,
 		NewWebhooksDataSource,
 		NewMaintenanceWindowsDataSource,
+		NewCheckDataSource,
+		NewHeartbeatDataSource,
+		NewStatusPageDataSource,
+		NewWebhookDataSource,
+		NewMaintenanceWindowDataSource,
+		NewStatusPageComponentsDataSource,
+		NewStatusPageComponentGroupsDataSource,
+		NewStatusPageIncidentsDataSource,
+		NewStatusPageScheduledMaintenancesDataSource,
+		NewCheckStatsDataSource,
+		NewCheckIncidentsDataSource,
+		NewHeartbeatIncidentsDataSource,
+		NewCheckStatusDataSource,
+		NewRegionsDataSource,
+		NewSlackChannelDataSource,
+		NewDiscordChannelDataSource,
+		NewTeamsChannelDataSource,
+		NewIncidentIOIntegrationDataSource,
+		NewOncallScheduleDataSource,
+		NewAccountDataSource,
+	}
+}
+
+func (p *OnlineornotProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewUptimeCheckListResource,
+		NewBrowserCheckListResource,
+		NewDNSCheckListResource,
+		NewTCPCheckListResource,
+		NewHeartbeatListResource,
+		NewStatusPageListResource,
+		NewWebhookListResource,
+	}
+}
+
+func (p *OnlineornotProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewDeployMaintenanceEphemeralResource,
+	}
+}
+
+func (p *OnlineornotProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewRunCheckAction,
+		NewPostIncidentUpdateAction,
+	}
+}
+
+func (p *OnlineornotProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewHeartbeatPingURLFunction,
+		NewStatusPageURLFunction,
+		NewCronNextRunsFunction,
+		NewParseChildIDFunction,
 	}
 }
 
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+var _ datasource.DataSource = &RegionsDataSource{}
+var _ datasource.DataSourceWithConfigure = &RegionsDataSource{}
+
+func NewRegionsDataSource() datasource.DataSource {
+	return &RegionsDataSource{}
+}
+
+// RegionsDataSource defines the data source implementation.
+type RegionsDataSource struct {
+	client *client.Client
+}
+
+// RegionsDataSourceModel describes the data source data model.
+type RegionsDataSourceModel struct {
+	Regions []regionModel `tfsdk:"regions"`
+}
+
+type regionModel struct {
+	Id                    types.String `tfsdk:"id"`
+	Provider              types.String `tfsdk:"provider"`
+	Continent             types.String `tfsdk:"continent"`
+	SupportsBrowserChecks types.Bool   `tfsdk:"supports_browser_checks"`
+}
+
+func (d *RegionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regions"
+}
+
+func (d *RegionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the probe regions checks can run from. If the API cannot be reached, the list built into the provider is returned with a warning.",
+		Attributes: map[string]schema.Attribute{
+			"regions": schema.ListNestedAttribute{
+				Description: "List of supported regions",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Region ID, as used in test_regions, e.g. aws:us-east-1",
+							Computed:    true,
+						},
+						"provider": schema.StringAttribute{
+							Description: "Cloud provider hosting the region",
+							Computed:    true,
+						},
+						"continent": schema.StringAttribute{
+							Description: "Continent the region is located in",
+							Computed:    true,
+						},
+						"supports_browser_checks": schema.BoolAttribute{
+							Description: "Whether browser checks can run from the region",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RegionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *RegionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	regions, err := d.client.ListRegions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Using Built-in Region List",
+			fmt.Sprintf("Unable to read regions, got error: %s. The regions built into the provider are returned instead and may be out of date.", err),
+		)
+		regions = client.DefaultRegions()
+	}
+
+	data := RegionsDataSourceModel{Regions: []regionModel{}}
+	for _, region := range regions {
+		data.Regions = append(data.Regions, regionModel{
+			Id:                    types.StringValue(region.ID),
+			Provider:              types.StringValue(region.Provider),
+			Continent:             types.StringValue(region.Continent),
+			SupportsBrowserChecks: types.BoolValue(region.SupportsBrowserChecks),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// validateTestRegions rejects planned test_regions entries that are not in
+// the regions catalogue returned by the API. The regions built into the
+// provider are used only when the catalogue cannot be read. Regions already
+// in the state are not checked again, so a region retired from the
+// catalogue does not block unrelated changes to the check.
+func validateTestRegions(ctx context.Context, c *client.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if c == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planned, current types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("test_regions"), &planned)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("test_regions"), &current)...)
+	}
+	if planned.IsNull() || planned.IsUnknown() || planned.Equal(current) {
+		return
+	}
+
+	unchanged := map[string]bool{}
+	for _, element := range current.Elements() {
+		if region, ok := element.(types.String); ok {
+			unchanged[region.ValueString()] = true
+		}
+	}
+
+	catalogue := "the regions catalogue"
+	regions, err := c.ListRegions(ctx)
+	if err != nil {
+		catalogue = fmt.Sprintf("the regions built into the provider, as the regions catalogue could not be read (%s)", err)
+		regions = client.DefaultRegions()
+	}
+	known := map[string]bool{}
+	for _, region := range regions {
+		known[region.ID] = true
+	}
+
+	for i, element := range planned.Elements() {
+		region, ok := element.(types.String)
+		if !ok || region.IsNull() || region.IsUnknown() || known[region.ValueString()] || unchanged[region.ValueString()] {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("test_regions").AtListIndex(i),
+			"Unknown Region",
+			fmt.Sprintf("%q is not one of the regions checks can run from, according to %s. See the onlineornot_regions data source for the supported regions.", region.ValueString(), catalogue),
+		)
+	}
+}
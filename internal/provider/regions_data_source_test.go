@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+func TestRegionsDataSource_Fallback(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		http.Error(w, `{"success": false, "errors": [{"message": "unavailable"}]}`, http.StatusServiceUnavailable)
+	}))
+	defer api.Close()
+
+	server := testProviderServer(t, api.URL)
+
+	resp, attrs := testReadDataSource(t, server, "onlineornot_regions", nil)
+	if len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Severity != tfprotov6.DiagnosticSeverityWarning {
+		t.Fatalf("expected a single warning, got %v", resp.Diagnostics)
+	}
+
+	var regions []tftypes.Value
+	attrs["regions"].As(&regions)
+	if len(regions) != len(client.DefaultRegions()) {
+		t.Errorf("got %d regions, want the %d built-in regions", len(regions), len(client.DefaultRegions()))
+	}
+}
+
+func TestValidateTestRegions(t *testing.T) {
+	ctx := context.Background()
+
+	var available bool
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !available {
+			http.Error(w, `{"success": false, "errors": [{"message": "unavailable"}]}`, http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"success": true, "result": []client.Region{
+			{ID: "aws:us-east-1", Provider: "aws"},
+			{ID: "aws:sa-east-1", Provider: "aws"},
+		}})
+	}))
+	defer api.Close()
+
+	schema := resourceSchema(ctx, NewDNSCheckResource())
+	objectType := schema.Type().TerraformType(ctx).(tftypes.Object)
+	check := func(regions []string) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		if regions != nil {
+			elements := []tftypes.Value{}
+			for _, region := range regions {
+				elements = append(elements, tftypes.NewValue(tftypes.String, region))
+			}
+			values["test_regions"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements)
+		}
+		return tftypes.NewValue(objectType, values)
+	}
+
+	cases := map[string]struct {
+		available bool
+		state     []string
+		planned   []string
+		errors    int
+	}{
+		"known":                {true, nil, []string{"aws:us-east-1", "aws:sa-east-1"}, 0},
+		"typo":                 {true, nil, []string{"aws:us-east-1", "aws:us-east1"}, 1},
+		"no type":              {true, nil, []string{"us-east-1"}, 1},
+		"only in built-in":     {true, nil, []string{"aws:eu-west-2"}, 1},
+		"already in state":     {true, []string{"aws:retired-1"}, []string{"aws:retired-1", "aws:us-east-1"}, 0},
+		"fallback known":       {false, nil, []string{"aws:eu-west-2"}, 0},
+		"fallback typo":        {false, nil, []string{"aws:eu-west2"}, 1},
+		"fallback only in api": {false, nil, []string{"aws:sa-east-1"}, 1},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			available = tc.available
+			// A new client per case, so the regions catalogue is not cached
+			// across cases
+			c := client.NewClient(&client.Config{APIKey: "test", BaseURL: api.URL})
+
+			state := tftypes.NewValue(objectType, nil)
+			if tc.state != nil {
+				state = check(tc.state)
+			}
+			req := resource.ModifyPlanRequest{
+				Plan:  tfsdk.Plan{Schema: schema, Raw: check(tc.planned)},
+				State: tfsdk.State{Schema: schema, Raw: state},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			validateTestRegions(ctx, c, req, resp)
+			if resp.Diagnostics.ErrorsCount() != tc.errors || resp.Diagnostics.WarningsCount() != 0 {
+				t.Errorf("expected %d errors and no warnings, got %v", tc.errors, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+const runCheckDefaultTimeout = 300
+
+var _ action.Action = &RunCheckAction{}
+var _ action.ActionWithConfigure = &RunCheckAction{}
+
+func NewRunCheckAction() action.Action {
+	return &RunCheckAction{pollInterval: 5 * time.Second}
+}
+
+// RunCheckAction defines the action implementation.
+type RunCheckAction struct {
+	client       *client.Client
+	pollInterval time.Duration
+}
+
+// RunCheckActionModel describes the action data model.
+type RunCheckActionModel struct {
+	CheckId        types.String `tfsdk:"check_id"`
+	Wait           types.Bool   `tfsdk:"wait"`
+	TimeoutSeconds types.Int64  `tfsdk:"timeout_seconds"`
+	FailOnDown     types.Bool   `tfsdk:"fail_on_down"`
+}
+
+func (a *RunCheckAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run_check"
+}
+
+func (a *RunCheckAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Triggers an immediate run of a check outside its regular schedule, for example as a smoke test after a deploy.",
+		Attributes: map[string]schema.Attribute{
+			"check_id": schema.StringAttribute{
+				Description: "ID of the check to run",
+				Required:    true,
+			},
+			"wait": schema.BoolAttribute{
+				Description: "Wait for the run to finish and report its result. Defaults to false.",
+				Optional:    true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: fmt.Sprintf("How long to wait for the run to finish when `wait` is set, in seconds. Defaults to %d.", runCheckDefaultTimeout),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"fail_on_down": schema.BoolAttribute{
+				Description: "Fail the action when `wait` is set and the run does not report the check as UP. Defaults to true.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *RunCheckAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = c
+}
+
+func (a *RunCheckAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data RunCheckActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkID := data.CheckId.ValueString()
+	run, err := a.client.RunCheck(ctx, checkID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run check, got error: %s", err))
+		return
+	}
+	sendProgress(resp, fmt.Sprintf("Queued run %s of check %s", run.ID, checkID))
+
+	if !data.Wait.ValueBool() {
+		return
+	}
+
+	timeout := int64(runCheckDefaultTimeout)
+	if !data.TimeoutSeconds.IsNull() {
+		timeout = data.TimeoutSeconds.ValueInt64()
+	}
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+
+	for !run.Done() {
+		if time.Now().After(deadline) {
+			resp.Diagnostics.AddError(
+				"Check Run Timed Out",
+				fmt.Sprintf("Run %s of check %s did not finish within %d seconds, last status: %s", run.ID, checkID, timeout, run.Status),
+			)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			resp.Diagnostics.AddError("Check Run Cancelled", fmt.Sprintf("Stopped waiting for run %s of check %s: %s", run.ID, checkID, ctx.Err()))
+			return
+		case <-time.After(a.pollInterval):
+		}
+
+		run, err = a.client.GetCheckRun(ctx, checkID, run.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read check run, got error: %s", err))
+			return
+		}
+	}
+
+	result := fmt.Sprintf("Check %s is %s", checkID, run.Status)
+	if run.ResponseTime > 0 {
+		result += fmt.Sprintf(" (%d ms)", run.ResponseTime)
+	}
+	if run.Error != "" {
+		result += ": " + run.Error
+	}
+
+	if run.Status != client.CheckRunStatusUp && (data.FailOnDown.IsNull() || data.FailOnDown.ValueBool()) {
+		resp.Diagnostics.AddError("Check Run Failed", result)
+		return
+	}
+	sendProgress(resp, result)
+}
+
+// sendProgress reports a progress message if the caller accepts them
+func sendProgress(resp *action.InvokeResponse, message string) {
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+}
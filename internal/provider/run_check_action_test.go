@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+// testRunCheckAction invokes onlineornot_run_check with wait set against a
+// server whose run finishes with finalStatus after one poll.
+func testRunCheckAction(t *testing.T, finalStatus string, failOnDown any) (*action.InvokeResponse, []string) {
+	t.Helper()
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		run := client.CheckRun{ID: "run1", Status: client.CheckRunStatusQueued}
+		if req.Method == http.MethodGet {
+			run.Status = finalStatus
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"result": run, "success": true})
+	}))
+	t.Cleanup(server.Close)
+
+	a := &RunCheckAction{client: client.NewClient(&client.Config{APIKey: "test", BaseURL: server.URL})}
+
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+	configType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	var progress []string
+	resp := &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progress = append(progress, event.Message)
+		},
+	}
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(configType, map[string]tftypes.Value{
+				"check_id":        tftypes.NewValue(tftypes.String, "c1"),
+				"wait":            tftypes.NewValue(tftypes.Bool, true),
+				"timeout_seconds": tftypes.NewValue(tftypes.Number, nil),
+				"fail_on_down":    tftypes.NewValue(tftypes.Bool, failOnDown),
+			}),
+		},
+	}, resp)
+	return resp, progress
+}
+
+func TestRunCheckAction(t *testing.T) {
+	t.Run("up", func(t *testing.T) {
+		resp, progress := testRunCheckAction(t, "UP", nil)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		if len(progress) != 2 || progress[1] != "Check c1 is UP" {
+			t.Errorf("unexpected progress %v", progress)
+		}
+	})
+
+	t.Run("down", func(t *testing.T) {
+		resp, _ := testRunCheckAction(t, "DOWN", nil)
+		if !resp.Diagnostics.HasError() {
+			t.Fatal("expected an error for a DOWN run")
+		}
+	})
+
+	t.Run("down without fail_on_down", func(t *testing.T) {
+		resp, _ := testRunCheckAction(t, "DOWN", false)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+	})
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Schema versioning convention
+//
+// Every hand-written resource sets resp.Schema.Version from a
+// <resource>SchemaVersion constant after building its schema, including
+// resources whose schema comes from a generated *_gen.go file. When a schema
+// change would make existing state unreadable (renaming an attribute,
+// turning a list into a set, restructuring a nested block, changing stored
+// values), bump the constant and add an upgrader for the previous version to
+// the resource's UpgradeState map. Upgraders are built with jsonStateUpgrader
+// and a list of stateTransforms so each step stays small and testable against
+// fixtures of prior state in testdata/state.
+
+// stateTransform rewrites the JSON attributes of a prior state in place
+type stateTransform func(attributes map[string]any) error
+
+// jsonStateUpgrader returns an upgrader that applies transforms to the raw
+// JSON of a prior state and decodes the result with the current schema.
+// Attributes the current schema does not know about are dropped and missing
+// ones are read as null, so transforms only need to handle actual changes.
+func jsonStateUpgrader(current schema.Schema, transforms ...stateTransform) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			value, err := upgradeRawState(ctx, req.RawState, current, transforms)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					fmt.Sprintf("Upgrading the prior state to schema version %d failed: %s", current.Version, err),
+				)
+				return
+			}
+
+			dynamicValue, err := tfprotov6.NewDynamicValue(current.Type().TerraformType(ctx), value)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					fmt.Sprintf("Encoding the upgraded state failed: %s", err),
+				)
+				return
+			}
+			resp.DynamicValue = &dynamicValue
+		},
+	}
+}
+
+// upgradeRawState applies transforms to raw and decodes it with current
+func upgradeRawState(ctx context.Context, raw *tfprotov6.RawState, current schema.Schema, transforms []stateTransform) (tftypes.Value, error) {
+	if raw == nil || raw.JSON == nil {
+		return tftypes.Value{}, fmt.Errorf("prior state has no JSON data")
+	}
+
+	// UseNumber keeps numbers exactly as stored instead of round-tripping
+	// them through float64
+	decoder := json.NewDecoder(bytes.NewReader(raw.JSON))
+	decoder.UseNumber()
+
+	var attributes map[string]any
+	if err := decoder.Decode(&attributes); err != nil {
+		return tftypes.Value{}, fmt.Errorf("decoding prior state: %w", err)
+	}
+
+	for _, transform := range transforms {
+		if err := transform(attributes); err != nil {
+			return tftypes.Value{}, err
+		}
+	}
+
+	upgraded, err := json.Marshal(attributes)
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("encoding upgraded state: %w", err)
+	}
+
+	state := tfprotov6.RawState{JSON: upgraded}
+	return state.UnmarshalWithOpts(current.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+	})
+}
+
+// renameAttribute moves the value stored under from to to
+func renameAttribute(from, to string) stateTransform {
+	return func(attributes map[string]any) error {
+		if value, ok := attributes[from]; ok {
+			attributes[to] = value
+			delete(attributes, from)
+		}
+		return nil
+	}
+}
+
+// removeAttribute drops an attribute that no longer exists
+func removeAttribute(name string) stateTransform {
+	return func(attributes map[string]any) error {
+		delete(attributes, name)
+		return nil
+	}
+}
+
+// mapStringAttribute replaces stored string values of name found in mapping.
+// Null and unmapped values are left unchanged.
+func mapStringAttribute(name string, mapping map[string]string) stateTransform {
+	return func(attributes map[string]any) error {
+		value, ok := attributes[name].(string)
+		if !ok {
+			return nil
+		}
+		if replacement, ok := mapping[value]; ok {
+			attributes[name] = replacement
+		}
+		return nil
+	}
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// upgradeStateFixture runs upgrader against the prior state stored in
+// testdata/state/fixture and decodes the result with current into target.
+func upgradeStateFixture(t *testing.T, upgrader resource.StateUpgrader, current schema.Schema, fixture string, target any) {
+	t.Helper()
+	ctx := context.Background()
+
+	raw, err := os.ReadFile(filepath.Join("testdata", "state", fixture))
+	if err != nil {
+		t.Fatalf("reading fixture: %v", err)
+	}
+
+	resp := &resource.UpgradeStateResponse{}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("upgrading state: %v", resp.Diagnostics)
+	}
+
+	value, err := resp.DynamicValue.Unmarshal(current.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("decoding upgraded state: %v", err)
+	}
+	state := tfsdk.State{Schema: current, Raw: value}
+	if diags := state.Get(ctx, target); diags.HasError() {
+		t.Fatalf("reading upgraded state: %v", diags)
+	}
+}
+
+func TestJSONStateUpgrader(t *testing.T) {
+	var current resource.SchemaResponse
+	NewCheckResource().Schema(context.Background(), resource.SchemaRequest{}, &current)
+
+	var data checkResourceModel
+	upgradeStateFixture(t, jsonStateUpgrader(current.Schema, removeAttribute("legacy_notes")), current.Schema, "check.json", &data)
+
+	if data.Type.ValueString() != "UPTIME_CHECK" {
+		t.Errorf("type = %s, want UPTIME_CHECK", data.Type)
+	}
+	if data.Id.ValueString() != "a1b2c3d4" || data.Url.ValueString() != "https://example.com" {
+		t.Errorf("unexpected id/url %s / %s", data.Id, data.Url)
+	}
+	if data.TestInterval.ValueInt64() != 300 || data.Timeout.ValueInt64() != 10000 {
+		t.Errorf("unexpected test_interval/timeout %s / %s", data.TestInterval, data.Timeout)
+	}
+	var regions []string
+	data.TestRegions.ElementsAs(context.Background(), &regions, false)
+	if len(regions) != 2 || regions[1] != "aws:eu-west-2" {
+		t.Errorf("test_regions = %v", regions)
+	}
+	if !data.AdoptExisting.IsNull() {
+		t.Errorf("adopt_existing = %s, want null for state written before it existed", data.AdoptExisting)
+	}
+}
+
+// TestResourceSchemaVersions enforces the versioning convention: every
+// resource with a schema version above zero can upgrade state from each
+// earlier version.
+func TestResourceSchemaVersions(t *testing.T) {
+	ctx := context.Background()
+
+	for _, newResource := range New("test")().Resources(ctx) {
+		r := newResource()
+
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "onlineornot"}, &metadata)
+		var schemaResp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+		version := schemaResp.Schema.Version
+		if version == 0 {
+			continue
+		}
+
+		upgradable, ok := r.(resource.ResourceWithUpgradeState)
+		if !ok {
+			t.Errorf("%s: schema version %d but no UpgradeState implementation", metadata.TypeName, version)
+			continue
+		}
+		upgraders := upgradable.UpgradeState(ctx)
+		for v := int64(0); v < version; v++ {
+			if _, ok := upgraders[v]; !ok {
+				t.Errorf("%s: no state upgrader from version %d to %d", metadata.TypeName, v, version)
+			}
+		}
+	}
+}
+
+func TestStateTransforms(t *testing.T) {
+	attributes := map[string]any{
+		"old_name": "value",
+		"obsolete": true,
+		"kind":     "LEGACY",
+	}
+
+	transforms := []stateTransform{
+		renameAttribute("old_name", "new_name"),
+		removeAttribute("obsolete"),
+		mapStringAttribute("kind", map[string]string{"LEGACY": "CURRENT"}),
+		mapStringAttribute("missing", map[string]string{"LEGACY": "CURRENT"}),
+	}
+	for _, transform := range transforms {
+		if err := transform(attributes); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if _, ok := attributes["old_name"]; ok || attributes["new_name"] != "value" {
+		t.Errorf("rename failed: %v", attributes)
+	}
+	if _, ok := attributes["obsolete"]; ok {
+		t.Errorf("remove failed: %v", attributes)
+	}
+	if attributes["kind"] != "CURRENT" {
+		t.Errorf("map failed: %v", attributes)
+	}
+	if _, ok := attributes["missing"]; ok {
+		t.Errorf("mapping a missing attribute must not create it: %v", attributes)
+	}
+}
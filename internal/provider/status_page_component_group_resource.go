@@ -3,9 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -15,6 +13,9 @@ import (
 
 var _ resource.Resource = &StatusPageComponentGroupResource{}
 var _ resource.ResourceWithImportState = &StatusPageComponentGroupResource{}
+var _ resource.ResourceWithIdentity = &StatusPageComponentGroupResource{}
+
+const statusPageComponentGroupSchemaVersion = 0
 
 func NewStatusPageComponentGroupResource() resource.Resource {
 	return &StatusPageComponentGroupResource{}
@@ -30,6 +31,11 @@ func (r *StatusPageComponentGroupResource) Metadata(ctx context.Context, req res
 
 func (r *StatusPageComponentGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_status_page_component_group.StatusPageComponentGroupResourceSchema(ctx)
+	resp.Schema.Version = statusPageComponentGroupSchemaVersion
+}
+
+func (r *StatusPageComponentGroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = childIdentitySchema("Status Page Component Group")
 }
 
 func (r *StatusPageComponentGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -62,7 +68,7 @@ func (r *StatusPageComponentGroupResource) Create(ctx context.Context, req resou
 		Description: data.Description.ValueString(),
 	}
 
-	created, err := r.client.CreateStatusPageComponentGroup(data.StatusPageId.ValueString(), group)
+	created, err := r.client.CreateStatusPageComponentGroup(ctx, data.StatusPageId.ValueString(), group)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create status page component group, got error: %s", err))
 		return
@@ -76,6 +82,7 @@ func (r *StatusPageComponentGroupResource) Create(ctx context.Context, req resou
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setChildIdentity(ctx, resp.Identity, data.StatusPageId.ValueString(), data.Id.ValueString())...)
 }
 
 func (r *StatusPageComponentGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -86,7 +93,7 @@ func (r *StatusPageComponentGroupResource) Read(ctx context.Context, req resourc
 		return
 	}
 
-	group, err := r.client.GetStatusPageComponentGroup(data.StatusPageId.ValueString(), data.Id.ValueString())
+	group, err := r.client.GetStatusPageComponentGroup(ctx, data.StatusPageId.ValueString(), data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read status page component group, got error: %s", err))
 		return
@@ -97,6 +104,7 @@ func (r *StatusPageComponentGroupResource) Read(ctx context.Context, req resourc
 	data.Description = types.StringValue(group.Description)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setChildIdentity(ctx, resp.Identity, data.StatusPageId.ValueString(), data.Id.ValueString())...)
 }
 
 func (r *StatusPageComponentGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -112,13 +120,14 @@ func (r *StatusPageComponentGroupResource) Update(ctx context.Context, req resou
 		Description: data.Description.ValueString(),
 	}
 
-	_, err := r.client.UpdateStatusPageComponentGroup(data.StatusPageId.ValueString(), data.Id.ValueString(), group)
+	_, err := r.client.UpdateStatusPageComponentGroup(ctx, data.StatusPageId.ValueString(), data.Id.ValueString(), group)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update status page component group, got error: %s", err))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setChildIdentity(ctx, resp.Identity, data.StatusPageId.ValueString(), data.Id.ValueString())...)
 }
 
 func (r *StatusPageComponentGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -129,7 +138,7 @@ func (r *StatusPageComponentGroupResource) Delete(ctx context.Context, req resou
 		return
 	}
 
-	err := r.client.DeleteStatusPageComponentGroup(data.StatusPageId.ValueString(), data.Id.ValueString())
+	err := r.client.DeleteStatusPageComponentGroup(ctx, data.StatusPageId.ValueString(), data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete status page component group, got error: %s", err))
 		return
@@ -137,16 +146,6 @@ func (r *StatusPageComponentGroupResource) Delete(ctx context.Context, req resou
 }
 
 func (r *StatusPageComponentGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: status_page_id/group_id
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: status_page_id/group_id, got: %s", req.ID),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("status_page_id"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	// Import format: status_page_id/group_id, or an identity block
+	importChildState(ctx, req, resp, "group_id")
 }
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/provider/resource_status_page_component_group"
+)
+
+var _ datasource.DataSourceWithConfigure = &apiCollectionDataSource[client.StatusPageComponentGroup, resource_status_page_component_group.StatusPageComponentGroupModel]{}
+
+func NewStatusPageComponentGroupsDataSource() datasource.DataSource {
+	return &apiCollectionDataSource[client.StatusPageComponentGroup, resource_status_page_component_group.StatusPageComponentGroupModel]{
+		typeName:    "status_page_component_groups",
+		object:      "status page component group",
+		description: "Fetches the component groups of a status page, optionally filtered.",
+		resource:    NewStatusPageComponentGroupResource,
+		parent:      "status_page_id",
+		filters: map[string]collectionFilter[client.StatusPageComponentGroup]{
+			"name_regex": nameRegexFilter("status page component group", "name", func(group client.StatusPageComponentGroup) string { return group.Name }),
+		},
+		listIn: func(c *client.Client, ctx context.Context, statusPageID string) ([]client.StatusPageComponentGroup, error) {
+			groups, err := c.ListStatusPageComponentGroups(ctx, statusPageID)
+			for i := range groups {
+				groups[i].StatusPageID = statusPageID
+			}
+			return groups, err
+		},
+		model: func(ctx context.Context, group client.StatusPageComponentGroup, diags *diag.Diagnostics) resource_status_page_component_group.StatusPageComponentGroupModel {
+			return resource_status_page_component_group.StatusPageComponentGroupModel{
+				Id:           types.StringValue(group.ID),
+				StatusPageId: types.StringValue(group.StatusPageID),
+				Name:         types.StringValue(group.Name),
+				Description:  optionalStringValue(group.Description),
+			}
+		},
+	}
+}
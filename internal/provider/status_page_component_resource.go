@@ -3,9 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -15,11 +13,21 @@ import (
 
 var _ resource.Resource = &StatusPageComponentResource{}
 var _ resource.ResourceWithImportState = &StatusPageComponentResource{}
+var _ resource.ResourceWithIdentity = &StatusPageComponentResource{}
+
+const statusPageComponentSchemaVersion = 0
 
 func NewStatusPageComponentResource() resource.Resource {
 	return &StatusPageComponentResource{}
 }
 
+// statusPageComponentResourceModel adds deletion_protection, which blocks
+// Delete until it is turned off, to the generated component model.
+type statusPageComponentResourceModel struct {
+	resource_status_page_component.StatusPageComponentModel
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+}
+
 type StatusPageComponentResource struct {
 	client *client.Client
 }
@@ -30,6 +38,12 @@ func (r *StatusPageComponentResource) Metadata(ctx context.Context, req resource
 
 func (r *StatusPageComponentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_status_page_component.StatusPageComponentResourceSchema(ctx)
+	resp.Schema.Version = statusPageComponentSchemaVersion
+	resp.Schema.Attributes["deletion_protection"] = deletionProtectionAttribute("component")
+}
+
+func (r *StatusPageComponentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = childIdentitySchema("Status Page Component")
 }
 
 func (r *StatusPageComponentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,7 +64,7 @@ func (r *StatusPageComponentResource) Configure(ctx context.Context, req resourc
 }
 
 func (r *StatusPageComponentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data resource_status_page_component.StatusPageComponentModel
+	var data statusPageComponentResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -71,7 +85,7 @@ func (r *StatusPageComponentResource) Create(ctx context.Context, req resource.C
 		comp.DisplayMetrics = &v
 	}
 
-	created, err := r.client.CreateStatusPageComponent(data.StatusPageId.ValueString(), comp)
+	created, err := r.client.CreateStatusPageComponent(ctx, data.StatusPageId.ValueString(), comp)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create status page component, got error: %s", err))
 		return
@@ -80,17 +94,18 @@ func (r *StatusPageComponentResource) Create(ctx context.Context, req resource.C
 	data.Id = types.StringValue(created.ID)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setChildIdentity(ctx, resp.Identity, data.StatusPageId.ValueString(), data.Id.ValueString())...)
 }
 
 func (r *StatusPageComponentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data resource_status_page_component.StatusPageComponentModel
+	var data statusPageComponentResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	comp, err := r.client.GetStatusPageComponent(data.StatusPageId.ValueString(), data.Id.ValueString())
+	comp, err := r.client.GetStatusPageComponent(ctx, data.StatusPageId.ValueString(), data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read status page component, got error: %s", err))
 		return
@@ -99,12 +114,16 @@ func (r *StatusPageComponentResource) Read(ctx context.Context, req resource.Rea
 	data.Id = types.StringValue(comp.ID)
 	data.Name = types.StringValue(comp.Name)
 	data.Status = types.StringValue(comp.Status)
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(false)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setChildIdentity(ctx, resp.Identity, data.StatusPageId.ValueString(), data.Id.ValueString())...)
 }
 
 func (r *StatusPageComponentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data resource_status_page_component.StatusPageComponentModel
+	var data statusPageComponentResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -125,24 +144,29 @@ func (r *StatusPageComponentResource) Update(ctx context.Context, req resource.U
 		comp.DisplayMetrics = &v
 	}
 
-	_, err := r.client.UpdateStatusPageComponent(data.StatusPageId.ValueString(), data.Id.ValueString(), comp)
+	_, err := r.client.UpdateStatusPageComponent(ctx, data.StatusPageId.ValueString(), data.Id.ValueString(), comp)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update status page component, got error: %s", err))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setChildIdentity(ctx, resp.Identity, data.StatusPageId.ValueString(), data.Id.ValueString())...)
 }
 
 func (r *StatusPageComponentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data resource_status_page_component.StatusPageComponentModel
+	var data statusPageComponentResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := r.client.DeleteStatusPageComponent(data.StatusPageId.ValueString(), data.Id.ValueString())
+	if !checkDeletionProtection(data.DeletionProtection, "status page component", data.Id.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	err := r.client.DeleteStatusPageComponent(ctx, data.StatusPageId.ValueString(), data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete status page component, got error: %s", err))
 		return
@@ -150,16 +174,6 @@ func (r *StatusPageComponentResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *StatusPageComponentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: status_page_id/component_id
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: status_page_id/component_id, got: %s", req.ID),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("status_page_id"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	// Import format: status_page_id/component_id, or an identity block
+	importChildState(ctx, req, resp, "component_id")
 }
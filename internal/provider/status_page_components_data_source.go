@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/provider/resource_status_page_component"
+)
+
+var _ datasource.DataSourceWithConfigure = &apiCollectionDataSource[client.StatusPageComponent, resource_status_page_component.StatusPageComponentModel]{}
+
+func NewStatusPageComponentsDataSource() datasource.DataSource {
+	return &apiCollectionDataSource[client.StatusPageComponent, resource_status_page_component.StatusPageComponentModel]{
+		typeName:    "status_page_components",
+		object:      "status page component",
+		description: "Fetches the components of a status page, optionally filtered.",
+		resource:    NewStatusPageComponentResource,
+		exclude:     []string{"deletion_protection"},
+		parent:      "status_page_id",
+		filters: map[string]collectionFilter[client.StatusPageComponent]{
+			"name_regex": nameRegexFilter("status page component", "name", func(comp client.StatusPageComponent) string { return comp.Name }),
+			"status": equalFoldFilter("Only return components with this status, e.g. OPERATIONAL.", func(comp client.StatusPageComponent) string {
+				return comp.Status
+			}),
+		},
+		listIn: func(c *client.Client, ctx context.Context, statusPageID string) ([]client.StatusPageComponent, error) {
+			components, err := c.ListStatusPageComponents(ctx, statusPageID)
+			for i := range components {
+				components[i].StatusPageID = statusPageID
+			}
+			return components, err
+		},
+		model: statusPageComponentModelFromAPI,
+	}
+}
+
+func statusPageComponentModelFromAPI(ctx context.Context, comp client.StatusPageComponent, diags *diag.Diagnostics) resource_status_page_component.StatusPageComponentModel {
+	return resource_status_page_component.StatusPageComponentModel{
+		Id:             types.StringValue(comp.ID),
+		StatusPageId:   types.StringValue(comp.StatusPageID),
+		Name:           types.StringValue(comp.Name),
+		Status:         optionalStringValue(comp.Status),
+		DisplayUptime:  types.BoolPointerValue(comp.DisplayUptime),
+		DisplayMetrics: types.BoolPointerValue(comp.DisplayMetrics),
+	}
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &apiLookupDataSource[client.StatusPage, statusPageDataSourceModel]{}
+
+// statusPageDataSourceModel is the status page resource model without the
+// write-only password and the resource-only settings
+type statusPageDataSourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Subdomain             types.String `tfsdk:"subdomain"`
+	Description           types.String `tfsdk:"description"`
+	CustomDomain          types.String `tfsdk:"custom_domain"`
+	HideFromSearchEngines types.Bool   `tfsdk:"hide_from_search_engines"`
+	AllowedIps            types.List   `tfsdk:"allowed_ips"`
+}
+
+func NewStatusPageDataSource() datasource.DataSource {
+	return &apiLookupDataSource[client.StatusPage, statusPageDataSourceModel]{
+		typeName:    "status_page",
+		description: "Looks up a single status page by `id`, `name` or `subdomain`.",
+		resource:    NewStatusPageResource,
+		exclude:     []string{"password", "deletion_protection"},
+		list:        (*client.Client).ListStatusPages,
+		lookup: objectLookup[client.StatusPage]{
+			object:   "status page",
+			id:       func(sp client.StatusPage) string { return sp.ID },
+			describe: func(sp client.StatusPage) string { return fmt.Sprintf("%s, %s", sp.Name, sp.Subdomain) },
+			fields: map[string]func(client.StatusPage) string{
+				"id":        func(sp client.StatusPage) string { return sp.ID },
+				"name":      func(sp client.StatusPage) string { return sp.Name },
+				"subdomain": func(sp client.StatusPage) string { return sp.Subdomain },
+			},
+		},
+		model: statusPageDataSourceModelFromAPI,
+	}
+}
+
+// statusPageDataSourceModelFromAPI builds a data source model from an API
+// status page
+func statusPageDataSourceModelFromAPI(ctx context.Context, sp client.StatusPage, diags *diag.Diagnostics) statusPageDataSourceModel {
+	data := statusPageModelFromAPI(ctx, &sp, diags)
+	return statusPageDataSourceModel{
+		Id:                    data.Id,
+		Name:                  data.Name,
+		Subdomain:             data.Subdomain,
+		Description:           data.Description,
+		CustomDomain:          data.CustomDomain,
+		HideFromSearchEngines: data.HideFromSearchEngines,
+		AllowedIps:            data.AllowedIps,
+	}
+}
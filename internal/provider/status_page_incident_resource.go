@@ -3,9 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -15,6 +13,9 @@ import (
 
 var _ resource.Resource = &StatusPageIncidentResource{}
 var _ resource.ResourceWithImportState = &StatusPageIncidentResource{}
+var _ resource.ResourceWithIdentity = &StatusPageIncidentResource{}
+
+const statusPageIncidentSchemaVersion = 0
 
 func NewStatusPageIncidentResource() resource.Resource {
 	return &StatusPageIncidentResource{}
@@ -30,6 +31,11 @@ func (r *StatusPageIncidentResource) Metadata(ctx context.Context, req resource.
 
 func (r *StatusPageIncidentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_status_page_incident.StatusPageIncidentResourceSchema(ctx)
+	resp.Schema.Version = statusPageIncidentSchemaVersion
+}
+
+func (r *StatusPageIncidentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = childIdentitySchema("Status Page Incident")
 }
 
 func (r *StatusPageIncidentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -80,7 +86,7 @@ func (r *StatusPageIncidentResource) Create(ctx context.Context, req resource.Cr
 		}
 	}
 
-	created, err := r.client.CreateStatusPageIncident(data.StatusPageId.ValueString(), incident)
+	created, err := r.client.CreateStatusPageIncident(ctx, data.StatusPageId.ValueString(), incident)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create status page incident, got error: %s", err))
 		return
@@ -99,6 +105,7 @@ func (r *StatusPageIncidentResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setChildIdentity(ctx, resp.Identity, data.StatusPageId.ValueString(), data.Id.ValueString())...)
 }
 
 func (r *StatusPageIncidentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -109,7 +116,7 @@ func (r *StatusPageIncidentResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	incident, err := r.client.GetStatusPageIncident(data.StatusPageId.ValueString(), data.Id.ValueString())
+	incident, err := r.client.GetStatusPageIncident(ctx, data.StatusPageId.ValueString(), data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read status page incident, got error: %s", err))
 		return
@@ -121,6 +128,7 @@ func (r *StatusPageIncidentResource) Read(ctx context.Context, req resource.Read
 	data.Status = types.StringValue(incident.Status)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setChildIdentity(ctx, resp.Identity, data.StatusPageId.ValueString(), data.Id.ValueString())...)
 }
 
 func (r *StatusPageIncidentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -154,13 +162,14 @@ func (r *StatusPageIncidentResource) Update(ctx context.Context, req resource.Up
 		}
 	}
 
-	_, err := r.client.UpdateStatusPageIncident(data.StatusPageId.ValueString(), data.Id.ValueString(), incident)
+	_, err := r.client.UpdateStatusPageIncident(ctx, data.StatusPageId.ValueString(), data.Id.ValueString(), incident)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update status page incident, got error: %s", err))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setChildIdentity(ctx, resp.Identity, data.StatusPageId.ValueString(), data.Id.ValueString())...)
 }
 
 func (r *StatusPageIncidentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -171,7 +180,7 @@ func (r *StatusPageIncidentResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	err := r.client.DeleteStatusPageIncident(data.StatusPageId.ValueString(), data.Id.ValueString())
+	err := r.client.DeleteStatusPageIncident(ctx, data.StatusPageId.ValueString(), data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete status page incident, got error: %s", err))
 		return
@@ -179,16 +188,6 @@ func (r *StatusPageIncidentResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *StatusPageIncidentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: status_page_id/incident_id
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: status_page_id/incident_id, got: %s", req.ID),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("status_page_id"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	// Import format: status_page_id/incident_id, or an identity block
+	importChildState(ctx, req, resp, "incident_id")
 }
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/provider/resource_status_page_incident"
+)
+
+// incidentStatusUnresolved is accepted by the status filter to match every
+// incident that has not been resolved yet
+const incidentStatusUnresolved = "UNRESOLVED"
+
+var _ datasource.DataSourceWithConfigure = &apiCollectionDataSource[client.StatusPageIncident, statusPageIncidentDataSourceModel]{}
+
+// statusPageIncidentDataSourceModel is the incident model without the
+// write-only notify_subscribers argument
+type statusPageIncidentDataSourceModel struct {
+	Components   types.List   `tfsdk:"components"`
+	Description  types.String `tfsdk:"description"`
+	Id           types.String `tfsdk:"id"`
+	Status       types.String `tfsdk:"status"`
+	StatusPageId types.String `tfsdk:"status_page_id"`
+	Title        types.String `tfsdk:"title"`
+}
+
+func NewStatusPageIncidentsDataSource() datasource.DataSource {
+	return &apiCollectionDataSource[client.StatusPageIncident, statusPageIncidentDataSourceModel]{
+		typeName:    "status_page_incidents",
+		object:      "status page incident",
+		description: "Fetches the incidents of a status page, optionally filtered.",
+		resource:    NewStatusPageIncidentResource,
+		exclude:     []string{"notify_subscribers"},
+		parent:      "status_page_id",
+		filters: map[string]collectionFilter[client.StatusPageIncident]{
+			"title_regex": nameRegexFilter("incident", "title", func(incident client.StatusPageIncident) string { return incident.Title }),
+			"status": {
+				description: fmt.Sprintf("Only return incidents with this status, e.g. INVESTIGATING. Use %s to return every incident that is not RESOLVED.", incidentStatusUnresolved),
+				predicate: func(value string) (func(client.StatusPageIncident) bool, error) {
+					if strings.EqualFold(value, incidentStatusUnresolved) {
+						return func(incident client.StatusPageIncident) bool {
+							return !strings.EqualFold(incident.Status, "RESOLVED")
+						}, nil
+					}
+					return func(incident client.StatusPageIncident) bool {
+						return strings.EqualFold(incident.Status, value)
+					}, nil
+				},
+			},
+		},
+		listIn: func(c *client.Client, ctx context.Context, statusPageID string) ([]client.StatusPageIncident, error) {
+			incidents, err := c.ListStatusPageIncidents(ctx, statusPageID)
+			for i := range incidents {
+				incidents[i].StatusPageID = statusPageID
+			}
+			return incidents, err
+		},
+		model: statusPageIncidentDataSourceModelFromAPI,
+	}
+}
+
+func statusPageIncidentDataSourceModelFromAPI(ctx context.Context, incident client.StatusPageIncident, diags *diag.Diagnostics) statusPageIncidentDataSourceModel {
+	return statusPageIncidentDataSourceModel{
+		Id:           types.StringValue(incident.ID),
+		StatusPageId: types.StringValue(incident.StatusPageID),
+		Title:        types.StringValue(incident.Title),
+		Description:  types.StringValue(incident.Description),
+		Status:       types.StringValue(incident.Status),
+		Components:   incidentComponentListValue(ctx, incident.Components, diags),
+	}
+}
+
+func incidentComponentListValue(ctx context.Context, components []client.StatusPageIncidentComponent, diags *diag.Diagnostics) types.List {
+	attrTypes := resource_status_page_incident.ComponentsValue{}.AttributeTypes(ctx)
+	elemType := resource_status_page_incident.ComponentsType{ObjectType: types.ObjectType{AttrTypes: attrTypes}}
+	if len(components) == 0 {
+		return types.ListNull(elemType)
+	}
+
+	values := make([]attr.Value, 0, len(components))
+	for _, comp := range components {
+		value, d := resource_status_page_incident.NewComponentsValue(attrTypes, map[string]attr.Value{
+			"id":     types.StringValue(comp.ID),
+			"status": types.StringValue(comp.Status),
+		})
+		diags.Append(d...)
+		values = append(values, value)
+	}
+	result, d := types.ListValue(elemType, values)
+	diags.Append(d...)
+	return result
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/provider/resource_status_page"
+)
+
+var _ list.ListResourceWithConfigure = &apiListResource[client.StatusPage, statusPageListConfigModel]{}
+
+// statusPageListConfigModel holds the filters of the status page list block
+type statusPageListConfigModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+}
+
+func NewStatusPageListResource() list.ListResource {
+	return &apiListResource[client.StatusPage, statusPageListConfigModel]{
+		typeName: "status_page",
+		object:   "status page",
+		schema: listschema.Schema{
+			Description: "Lists status pages so they can be imported with `terraform query`.",
+			Attributes: map[string]listschema.Attribute{
+				"name_prefix": namePrefixAttribute("status page"),
+			},
+		},
+		list: (*client.Client).ListStatusPages,
+		match: func(config statusPageListConfigModel, sp client.StatusPage) bool {
+			return matchesPrefix(config.NamePrefix, sp.Name)
+		},
+		id:          func(sp client.StatusPage) string { return sp.ID },
+		displayName: func(sp client.StatusPage) string { return sp.Name },
+		model: func(ctx context.Context, sp client.StatusPage, diags *diag.Diagnostics) any {
+			return statusPageModelFromAPI(ctx, &sp, diags)
+		},
+	}
+}
+
+// statusPageModelFromAPI builds a complete resource model from an API status
+// page. The password is never returned by the API and is left null.
+func statusPageModelFromAPI(ctx context.Context, sp *client.StatusPage, diags *diag.Diagnostics) statusPageResourceModel {
+	return statusPageResourceModel{
+		StatusPageModel: resource_status_page.StatusPageModel{
+			Id:                    types.StringValue(sp.ID),
+			Name:                  types.StringValue(sp.Name),
+			Subdomain:             types.StringValue(sp.Subdomain),
+			Description:           optionalStringValue(sp.Description),
+			CustomDomain:          optionalStringValue(sp.CustomDomain),
+			HideFromSearchEngines: types.BoolValue(sp.HideFromSearchEngines),
+			AllowedIps:            stringListValue(ctx, sp.AllowedIPs, diags),
+			Password:              types.StringNull(),
+		},
+		DeletionProtection: types.BoolValue(false),
+	}
+}
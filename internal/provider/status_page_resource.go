@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -15,11 +14,21 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &StatusPageResource{}
 var _ resource.ResourceWithImportState = &StatusPageResource{}
+var _ resource.ResourceWithIdentity = &StatusPageResource{}
+
+const statusPageSchemaVersion = 0
 
 func NewStatusPageResource() resource.Resource {
 	return &StatusPageResource{}
 }
 
+// statusPageResourceModel adds deletion_protection, which the provider
+// enforces before deleting, to the generated status page model.
+type statusPageResourceModel struct {
+	resource_status_page.StatusPageModel
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+}
+
 // StatusPageResource defines the resource implementation.
 type StatusPageResource struct {
 	client *client.Client
@@ -31,6 +40,12 @@ func (r *StatusPageResource) Metadata(ctx context.Context, req resource.Metadata
 
 func (r *StatusPageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_status_page.StatusPageResourceSchema(ctx)
+	resp.Schema.Version = statusPageSchemaVersion
+	resp.Schema.Attributes["deletion_protection"] = deletionProtectionAttribute("status page")
+}
+
+func (r *StatusPageResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Status Page")
 }
 
 func (r *StatusPageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -51,7 +66,7 @@ func (r *StatusPageResource) Configure(ctx context.Context, req resource.Configu
 }
 
 func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data resource_status_page.StatusPageModel
+	var data statusPageResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -71,7 +86,7 @@ func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequ
 		data.AllowedIps.ElementsAs(ctx, &sp.AllowedIPs, false)
 	}
 
-	created, err := r.client.CreateStatusPage(sp)
+	created, err := r.client.CreateStatusPage(ctx, sp)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create status page, got error: %s", err))
 		return
@@ -94,17 +109,18 @@ func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *StatusPageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data resource_status_page.StatusPageModel
+	var data statusPageResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	sp, err := r.client.GetStatusPage(data.Id.ValueString())
+	sp, err := r.client.GetStatusPage(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read status page, got error: %s", err))
 		return
@@ -116,12 +132,16 @@ func (r *StatusPageResource) Read(ctx context.Context, req resource.ReadRequest,
 	data.Description = types.StringValue(sp.Description)
 	data.CustomDomain = types.StringValue(sp.CustomDomain)
 	data.HideFromSearchEngines = types.BoolValue(sp.HideFromSearchEngines)
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(false)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data resource_status_page.StatusPageModel
+	var data statusPageResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -141,24 +161,29 @@ func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequ
 		data.AllowedIps.ElementsAs(ctx, &sp.AllowedIPs, false)
 	}
 
-	_, err := r.client.UpdateStatusPage(data.Id.ValueString(), sp)
+	_, err := r.client.UpdateStatusPage(ctx, data.Id.ValueString(), sp)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update status page, got error: %s", err))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *StatusPageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data resource_status_page.StatusPageModel
+	var data statusPageResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err := r.client.DeleteStatusPage(data.Id.ValueString())
+	if !checkDeletionProtection(data.DeletionProtection, "status page", data.Id.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	err := r.client.DeleteStatusPage(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete status page, got error: %s", err))
 		return
@@ -166,5 +191,14 @@ func (r *StatusPageResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *StatusPageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import format: status_page_id, name=<name> or subdomain=<subdomain>
+	importStateByLookup(ctx, req, resp, r.client.ListStatusPages, objectLookup[client.StatusPage]{
+		object:   "status page",
+		id:       func(sp client.StatusPage) string { return sp.ID },
+		describe: func(sp client.StatusPage) string { return fmt.Sprintf("%s, %s", sp.Name, sp.Subdomain) },
+		fields: map[string]func(client.StatusPage) string{
+			"name":      func(sp client.StatusPage) string { return sp.Name },
+			"subdomain": func(sp client.StatusPage) string { return sp.Subdomain },
+		},
+	})
 }
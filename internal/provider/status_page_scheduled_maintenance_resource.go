@@ -3,9 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -15,6 +13,9 @@ import (
 
 var _ resource.Resource = &StatusPageScheduledMaintenanceResource{}
 var _ resource.ResourceWithImportState = &StatusPageScheduledMaintenanceResource{}
+var _ resource.ResourceWithIdentity = &StatusPageScheduledMaintenanceResource{}
+
+const statusPageScheduledMaintenanceSchemaVersion = 0
 
 func NewStatusPageScheduledMaintenanceResource() resource.Resource {
 	return &StatusPageScheduledMaintenanceResource{}
@@ -30,6 +31,11 @@ func (r *StatusPageScheduledMaintenanceResource) Metadata(ctx context.Context, r
 
 func (r *StatusPageScheduledMaintenanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_status_page_scheduled_maintenance.StatusPageScheduledMaintenanceResourceSchema(ctx)
+	resp.Schema.Version = statusPageScheduledMaintenanceSchemaVersion
+}
+
+func (r *StatusPageScheduledMaintenanceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = childIdentitySchema("Status Page Scheduled Maintenance")
 }
 
 func (r *StatusPageScheduledMaintenanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -77,7 +83,7 @@ func (r *StatusPageScheduledMaintenanceResource) Create(ctx context.Context, req
 		}
 	}
 
-	created, err := r.client.CreateStatusPageScheduledMaintenance(data.StatusPageId.ValueString(), sm)
+	created, err := r.client.CreateStatusPageScheduledMaintenance(ctx, data.StatusPageId.ValueString(), sm)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create scheduled maintenance, got error: %s", err))
 		return
@@ -94,6 +100,7 @@ func (r *StatusPageScheduledMaintenanceResource) Create(ctx context.Context, req
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setChildIdentity(ctx, resp.Identity, data.StatusPageId.ValueString(), data.Id.ValueString())...)
 }
 
 func (r *StatusPageScheduledMaintenanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -104,7 +111,7 @@ func (r *StatusPageScheduledMaintenanceResource) Read(ctx context.Context, req r
 		return
 	}
 
-	sm, err := r.client.GetStatusPageScheduledMaintenance(data.StatusPageId.ValueString(), data.Id.ValueString())
+	sm, err := r.client.GetStatusPageScheduledMaintenance(ctx, data.StatusPageId.ValueString(), data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scheduled maintenance, got error: %s", err))
 		return
@@ -117,6 +124,7 @@ func (r *StatusPageScheduledMaintenanceResource) Read(ctx context.Context, req r
 	data.DurationMinutes = types.Int64Value(int64(sm.DurationMinutes))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setChildIdentity(ctx, resp.Identity, data.StatusPageId.ValueString(), data.Id.ValueString())...)
 }
 
 func (r *StatusPageScheduledMaintenanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -147,13 +155,14 @@ func (r *StatusPageScheduledMaintenanceResource) Update(ctx context.Context, req
 		}
 	}
 
-	_, err := r.client.UpdateStatusPageScheduledMaintenance(data.StatusPageId.ValueString(), data.Id.ValueString(), sm)
+	_, err := r.client.UpdateStatusPageScheduledMaintenance(ctx, data.StatusPageId.ValueString(), data.Id.ValueString(), sm)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update scheduled maintenance, got error: %s", err))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setChildIdentity(ctx, resp.Identity, data.StatusPageId.ValueString(), data.Id.ValueString())...)
 }
 
 func (r *StatusPageScheduledMaintenanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -164,7 +173,7 @@ func (r *StatusPageScheduledMaintenanceResource) Delete(ctx context.Context, req
 		return
 	}
 
-	err := r.client.DeleteStatusPageScheduledMaintenance(data.StatusPageId.ValueString(), data.Id.ValueString())
+	err := r.client.DeleteStatusPageScheduledMaintenance(ctx, data.StatusPageId.ValueString(), data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete scheduled maintenance, got error: %s", err))
 		return
@@ -172,16 +181,6 @@ func (r *StatusPageScheduledMaintenanceResource) Delete(ctx context.Context, req
 }
 
 func (r *StatusPageScheduledMaintenanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: status_page_id/maintenance_id
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: status_page_id/maintenance_id, got: %s", req.ID),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("status_page_id"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	// Import format: status_page_id/maintenance_id, or an identity block
+	importChildState(ctx, req, resp, "maintenance_id")
 }
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/provider/resource_status_page_scheduled_maintenance"
+)
+
+var _ datasource.DataSourceWithConfigure = &apiCollectionDataSource[client.StatusPageScheduledMaintenance, resource_status_page_scheduled_maintenance.StatusPageScheduledMaintenanceModel]{}
+
+func NewStatusPageScheduledMaintenancesDataSource() datasource.DataSource {
+	return &apiCollectionDataSource[client.StatusPageScheduledMaintenance, resource_status_page_scheduled_maintenance.StatusPageScheduledMaintenanceModel]{
+		typeName:    "status_page_scheduled_maintenances",
+		object:      "status page scheduled maintenance",
+		description: "Fetches the scheduled maintenances of a status page, optionally filtered.",
+		resource:    NewStatusPageScheduledMaintenanceResource,
+		parent:      "status_page_id",
+		filters: map[string]collectionFilter[client.StatusPageScheduledMaintenance]{
+			"title_regex": nameRegexFilter("scheduled maintenance", "title", func(sm client.StatusPageScheduledMaintenance) string { return sm.Title }),
+		},
+		listIn: func(c *client.Client, ctx context.Context, statusPageID string) ([]client.StatusPageScheduledMaintenance, error) {
+			maintenances, err := c.ListStatusPageScheduledMaintenances(ctx, statusPageID)
+			for i := range maintenances {
+				maintenances[i].StatusPageID = statusPageID
+			}
+			return maintenances, err
+		},
+		model: statusPageScheduledMaintenanceModelFromAPI,
+	}
+}
+
+func statusPageScheduledMaintenanceModelFromAPI(ctx context.Context, sm client.StatusPageScheduledMaintenance, diags *diag.Diagnostics) resource_status_page_scheduled_maintenance.StatusPageScheduledMaintenanceModel {
+	model := resource_status_page_scheduled_maintenance.StatusPageScheduledMaintenanceModel{
+		Id:                 types.StringValue(sm.ID),
+		StatusPageId:       types.StringValue(sm.StatusPageID),
+		Title:              types.StringValue(sm.Title),
+		Description:        types.StringValue(sm.Description),
+		StartDate:          types.StringValue(sm.StartDate),
+		DurationMinutes:    types.Int64Value(int64(sm.DurationMinutes)),
+		ComponentsAffected: stringListValue(ctx, sm.ComponentsAffected, diags),
+		Notifications:      resource_status_page_scheduled_maintenance.NewNotificationsValueNull(),
+	}
+	if sm.Notifications != nil {
+		notifications, d := resource_status_page_scheduled_maintenance.NewNotificationsValue(
+			resource_status_page_scheduled_maintenance.NotificationsValue{}.AttributeTypes(ctx),
+			map[string]attr.Value{
+				"an_hour_before": types.BoolValue(sm.Notifications.AnHourBefore),
+				"at_start":       types.BoolValue(sm.Notifications.AtStart),
+				"at_end":         types.BoolValue(sm.Notifications.AtEnd),
+			},
+		)
+		diags.Append(d...)
+		model.Notifications = notifications
+	}
+	return model
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// statusPageDomain hosts status pages without a custom domain
+const statusPageDomain = "onlineornot.com"
+
+var _ function.Function = &StatusPageURLFunction{}
+
+func NewStatusPageURLFunction() function.Function {
+	return &StatusPageURLFunction{}
+}
+
+// StatusPageURLFunction defines the status_page_url function implementation.
+type StatusPageURLFunction struct{}
+
+func (f *StatusPageURLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "status_page_url"
+}
+
+func (f *StatusPageURLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build the public URL of a status page",
+		Description: "Returns the public URL of a status page. The custom domain is used when set, otherwise the page is served from `<subdomain>.onlineornot.com`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "subdomain",
+				Description: "The subdomain of the status page.",
+			},
+			function.StringParameter{
+				Name:           "custom_domain",
+				Description:    "The custom domain of the status page. Pass null or an empty string when the page has none.",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *StatusPageURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var subdomain string
+	var customDomain *string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &subdomain, &customDomain))
+	if resp.Error != nil {
+		return
+	}
+
+	if customDomain != nil && strings.TrimSpace(*customDomain) != "" {
+		host := strings.TrimSuffix(strings.TrimSpace(*customDomain), "/")
+		host = strings.TrimPrefix(strings.TrimPrefix(host, "https://"), "http://")
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, "https://"+host))
+		return
+	}
+
+	subdomain = strings.TrimSpace(subdomain)
+	if subdomain == "" {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid subdomain: a subdomain is required when no custom domain is set")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, "https://"+subdomain+"."+statusPageDomain))
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStatusPageURLFunction(t *testing.T) {
+	cases := map[string]struct {
+		subdomain    string
+		customDomain attr.Value
+		want         string
+	}{
+		"subdomain":          {"acme", types.StringNull(), "https://acme.onlineornot.com"},
+		"empty custom":       {"acme", types.StringValue(""), "https://acme.onlineornot.com"},
+		"custom domain":      {"acme", types.StringValue("status.acme.com"), "https://status.acme.com"},
+		"custom with scheme": {"acme", types.StringValue("https://status.acme.com/"), "https://status.acme.com"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := runFunction(t, NewStatusPageURLFunction(), types.StringValue(tc.subdomain), tc.customDomain)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if want := types.StringValue(tc.want); !result.Equal(want) {
+				t.Errorf("result = %s, want %s", result, want)
+			}
+		})
+	}
+
+	if _, err := runFunction(t, NewStatusPageURLFunction(), types.StringValue(""), types.StringNull()); err == nil {
+		t.Error("expected an error without a subdomain or custom domain")
+	}
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// timeWindowDefaultDays is how far back since reaches when it is not set
+const timeWindowDefaultDays = 30
+
+// timeWindowAttributes returns the since and until arguments of data sources
+// that report on a period of time
+func timeWindowAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"since": schema.StringAttribute{
+			Description: fmt.Sprintf("Start of the time window, in RFC 3339 format. Defaults to %d days before `until`.", timeWindowDefaultDays),
+			Optional:    true,
+			Computed:    true,
+		},
+		"until": schema.StringAttribute{
+			Description: "End of the time window, in RFC 3339 format. Defaults to the current time.",
+			Optional:    true,
+			Computed:    true,
+		},
+	}
+}
+
+// parseTimeWindow resolves the since and until arguments, filling in the
+// defaults relative to now. Arguments left unset are set to the resolved
+// times; configured ones are kept as written so the state matches the
+// configuration.
+func parseTimeWindow(since, until *types.String, now time.Time, diags *diag.Diagnostics) (time.Time, time.Time) {
+	end := now.UTC().Truncate(time.Second)
+	if !until.IsNull() && !until.IsUnknown() {
+		end = parseTimeAttribute(path.Root("until"), until.ValueString(), diags)
+	}
+	start := end.AddDate(0, 0, -timeWindowDefaultDays)
+	if !since.IsNull() && !since.IsUnknown() {
+		start = parseTimeAttribute(path.Root("since"), since.ValueString(), diags)
+	}
+	if diags.HasError() {
+		return start, end
+	}
+
+	if !start.Before(end) {
+		diags.AddAttributeError(path.Root("since"), "Invalid Time Window", fmt.Sprintf("since (%s) must be before until (%s)", start.Format(time.RFC3339), end.Format(time.RFC3339)))
+	}
+	if since.IsNull() || since.IsUnknown() {
+		*since = types.StringValue(start.UTC().Format(time.RFC3339))
+	}
+	if until.IsNull() || until.IsUnknown() {
+		*until = types.StringValue(end.UTC().Format(time.RFC3339))
+	}
+	return start, end
+}
+
+func parseTimeAttribute(p path.Path, value string, diags *diag.Diagnostics) time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		diags.AddAttributeError(p, "Invalid Time", fmt.Sprintf("Expected an RFC 3339 timestamp such as 2026-01-02T15:04:05Z, got %q", value))
+	}
+	return t
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -23,18 +22,31 @@ import (
 
 var _ resource.Resource = &DNSCheckResource{}
 var _ resource.ResourceWithImportState = &DNSCheckResource{}
+var _ resource.ResourceWithIdentity = &DNSCheckResource{}
+var _ resource.ResourceWithModifyPlan = &DNSCheckResource{}
 var _ resource.Resource = &TCPCheckResource{}
 var _ resource.ResourceWithImportState = &TCPCheckResource{}
+var _ resource.ResourceWithIdentity = &TCPCheckResource{}
+var _ resource.ResourceWithModifyPlan = &TCPCheckResource{}
+
+const (
+	dnsCheckSchemaVersion = 0
+	tcpCheckSchemaVersion = 0
+)
 
 type typedCheckModel struct {
+	monitorStatusModel
+	AdoptExisting                types.Bool   `tfsdk:"adopt_existing"`
 	AlertPriority                types.String `tfsdk:"alert_priority"`
 	Assertions                   types.List   `tfsdk:"assertions"`
 	ConfirmationPeriodSeconds    types.Int64  `tfsdk:"confirmation_period_seconds"`
 	DiscordAlerts                types.List   `tfsdk:"discord_alerts"`
 	Id                           types.String `tfsdk:"id"`
 	IncidentIoAlerts             types.List   `tfsdk:"incident_io_alerts"`
+	LastQueued                   types.String `tfsdk:"last_queued"`
 	MicrosoftTeamsAlerts         types.List   `tfsdk:"microsoft_teams_alerts"`
 	Name                         types.String `tfsdk:"name"`
+	OnDestroy                    types.String `tfsdk:"on_destroy"`
 	OncallAlerts                 types.List   `tfsdk:"oncall_alerts"`
 	RecoveryPeriodSeconds        types.Int64  `tfsdk:"recovery_period_seconds"`
 	ReminderAlertIntervalMinutes types.Int64  `tfsdk:"reminder_alert_interval_minutes"`
@@ -88,6 +100,14 @@ func (r *TCPCheckResource) Metadata(ctx context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_tcp_check"
 }
 
+func (r *DNSCheckResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("DNS Check")
+}
+
+func (r *TCPCheckResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("TCP Check")
+}
+
 func (r *DNSCheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	s := typedCheckSchema(ctx, "DNS check ID")
 	s.Attributes["dns_domain"] = schema.StringAttribute{Required: true, Description: "Domain name to query", MarkdownDescription: "Domain name to query"}
@@ -108,6 +128,8 @@ func (r *DNSCheckResource) Schema(ctx context.Context, req resource.SchemaReques
 		Validators:          []validator.String{stringvalidator.OneOf("UDP", "TCP", "HTTPS")},
 		Default:             stringdefault.StaticString("UDP"),
 	}
+	s.Attributes["adopt_existing"] = adoptExistingAttribute("DNS check", "name and domain")
+	s.Version = dnsCheckSchemaVersion
 	resp.Schema = s
 }
 
@@ -130,11 +152,13 @@ func (r *TCPCheckResource) Schema(ctx context.Context, req resource.SchemaReques
 	}
 	s.Attributes["tcp_data"] = schema.StringAttribute{Optional: true, Computed: true, Description: "Data to send after connecting", MarkdownDescription: "Data to send after connecting"}
 	s.Attributes["tcp_should_fail"] = schema.BoolAttribute{Optional: true, Computed: true, Description: "Whether the connection is expected to fail", MarkdownDescription: "Whether the connection is expected to fail", Default: booldefault.StaticBool(false)}
+	s.Attributes["adopt_existing"] = adoptExistingAttribute("TCP check", "name, hostname and port")
+	s.Version = tcpCheckSchemaVersion
 	resp.Schema = s
 }
 
 func typedCheckSchema(ctx context.Context, idDescription string) schema.Schema {
-	return schema.Schema{Attributes: map[string]schema.Attribute{
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
 		"alert_priority": schema.StringAttribute{
 			Optional:            true,
 			Computed:            true,
@@ -168,6 +192,7 @@ func typedCheckSchema(ctx context.Context, idDescription string) schema.Schema {
 			Validators:          []validator.String{stringvalidator.LengthAtLeast(8)},
 		},
 		"incident_io_alerts":              stringListAttribute(),
+		"last_queued":                     lastQueuedAttribute(),
 		"microsoft_teams_alerts":          stringListAttribute(),
 		"name":                            schema.StringAttribute{Required: true, Description: "Name of the monitor", MarkdownDescription: "Name of the monitor"},
 		"oncall_alerts":                   stringListAttribute(),
@@ -176,11 +201,14 @@ func typedCheckSchema(ctx context.Context, idDescription string) schema.Schema {
 		"slack_alerts":                    stringListAttribute(),
 		"telegram_alerts":                 stringListAttribute(),
 		"test_interval":                   schema.Int64Attribute{Optional: true, Computed: true, Description: "Interval in seconds between checks", MarkdownDescription: "Interval in seconds between checks", Validators: []validator.Int64{int64validator.AtLeast(30)}},
-		"test_regions":                    stringListAttribute(),
+		"test_regions":                    schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true, Description: "Regions to run checks from, e.g. aws:us-east-1. See the onlineornot_regions data source for the supported regions.", MarkdownDescription: "Regions to run checks from, e.g. aws:us-east-1. See the onlineornot_regions data source for the supported regions."},
 		"timeout":                         schema.Int64Attribute{Optional: true, Computed: true, Description: "Timeout in milliseconds", MarkdownDescription: "Timeout in milliseconds", Validators: []validator.Int64{int64validator.AtLeast(1000)}, Default: int64default.StaticInt64(10000)},
 		"user_alerts":                     stringListAttribute(),
 		"webhook_alerts":                  stringListAttribute(),
 	}}
+	addMonitorStatusAttributes(s.Attributes, "check")
+	s.Attributes["on_destroy"] = onDestroyAttribute("check")
+	return s
 }
 
 func stringListAttribute() schema.ListAttribute {
@@ -195,6 +223,16 @@ func (r *TCPCheckResource) Configure(ctx context.Context, req resource.Configure
 	r.client = configureTypedCheckClient(req.ProviderData, &resp.Diagnostics)
 }
 
+func (r *DNSCheckResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateTestRegions(ctx, r.client, req, resp)
+	warnOnPlanLimits(ctx, r.client, req, resp, false)
+}
+
+func (r *TCPCheckResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateTestRegions(ctx, r.client, req, resp)
+	warnOnPlanLimits(ctx, r.client, req, resp, false)
+}
+
 func configureTypedCheckClient(providerData any, diags *diag.Diagnostics) *client.Client {
 	if providerData == nil {
 		return nil
@@ -214,13 +252,42 @@ func (r *DNSCheckResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	created, err := r.client.CreateDNSCheck(dnsModelToClient(ctx, &data, &resp.Diagnostics))
+	check := dnsModelToClient(ctx, &data, &resp.Diagnostics)
+	if data.AdoptExisting.ValueBool() {
+		existingID := r.findExistingCheck(ctx, check, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if existingID != "" {
+			adopted, err := r.client.UpdateDNSCheck(ctx, existingID, check)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to adopt existing DNS check %s, got error: %s", existingID, err))
+				return
+			}
+			addAdoptedWarning(&resp.Diagnostics, "DNS check", existingID)
+			adopted.Status = applyCheckPaused(ctx, r.client, data.Paused, adopted.ID, adopted.Status, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			populateDNSModel(ctx, &data, adopted, &resp.Diagnostics)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
+			return
+		}
+	}
+
+	created, err := r.client.CreateDNSCheck(ctx, check)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DNS check, got error: %s", err))
 		return
 	}
+	created.Status = applyCheckPaused(ctx, r.client, data.Paused, created.ID, created.Status, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	populateDNSModel(ctx, &data, created, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *DNSCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -230,13 +297,14 @@ func (r *DNSCheckResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	check, err := r.client.GetDNSCheck(data.Id.ValueString())
+	check, err := r.client.GetDNSCheck(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS check, got error: %s", err))
 		return
 	}
 	populateDNSModel(ctx, &data, check, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *DNSCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -248,13 +316,20 @@ func (r *DNSCheckResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	updated, err := r.client.UpdateDNSCheck(state.Id.ValueString(), dnsModelToClient(ctx, &data, &resp.Diagnostics))
+	updated, err := r.client.UpdateDNSCheck(ctx, state.Id.ValueString(), dnsModelToClient(ctx, &data, &resp.Diagnostics))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DNS check, got error: %s", err))
 		return
 	}
+	updated.Status = applyCheckPaused(ctx, r.client, data.Paused, updated.ID, updated.Status, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plannedStatus := data.Status
 	populateDNSModel(ctx, &data, updated, &resp.Diagnostics)
+	data.keepPlannedStatus(plannedStatus)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *DNSCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -263,13 +338,38 @@ func (r *DNSCheckResource) Delete(ctx context.Context, req resource.DeleteReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := r.client.DeleteDNSCheck(data.Id.ValueString()); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DNS check, got error: %s", err))
+	if err := destroyMonitor(ctx, data.OnDestroy, data.Id.ValueString(), r.client.DeleteDNSCheck, r.client.PauseCheck); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to %s DNS check, got error: %s", onDestroyMode(data.OnDestroy), err))
+	}
+}
+
+// findExistingCheck returns the ID of an existing DNS check with the same
+// name and domain, or "" when there is none.
+func (r *DNSCheckResource) findExistingCheck(ctx context.Context, check *client.DNSCheck, diags *diag.Diagnostics) string {
+	checks, err := r.client.ListDNSChecks(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list DNS checks to adopt, got error: %s", err))
+		return ""
 	}
+
+	return findAdoptionCandidate(checks, "DNS check",
+		func(c client.DNSCheck) string { return c.ID },
+		func(c client.DNSCheck) bool { return c.Name == check.Name && c.DNSDomain == check.DNSDomain },
+		diags,
+	)
 }
 
 func (r *DNSCheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import format: check_id, name=<name> or domain=<domain>
+	importStateByLookup(ctx, req, resp, r.client.ListDNSChecks, objectLookup[client.DNSCheck]{
+		object:   "DNS check",
+		id:       func(c client.DNSCheck) string { return c.ID },
+		describe: func(c client.DNSCheck) string { return fmt.Sprintf("%s, %s", c.Name, c.DNSDomain) },
+		fields: map[string]func(client.DNSCheck) string{
+			"name":   func(c client.DNSCheck) string { return c.Name },
+			"domain": func(c client.DNSCheck) string { return c.DNSDomain },
+		},
+	})
 }
 
 func (r *TCPCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -279,13 +379,42 @@ func (r *TCPCheckResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	created, err := r.client.CreateTCPCheck(tcpModelToClient(ctx, &data, &resp.Diagnostics))
+	check := tcpModelToClient(ctx, &data, &resp.Diagnostics)
+	if data.AdoptExisting.ValueBool() {
+		existingID := r.findExistingCheck(ctx, check, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if existingID != "" {
+			adopted, err := r.client.UpdateTCPCheck(ctx, existingID, check)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to adopt existing TCP check %s, got error: %s", existingID, err))
+				return
+			}
+			addAdoptedWarning(&resp.Diagnostics, "TCP check", existingID)
+			adopted.Status = applyCheckPaused(ctx, r.client, data.Paused, adopted.ID, adopted.Status, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			populateTCPModel(ctx, &data, adopted, &resp.Diagnostics)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
+			return
+		}
+	}
+
+	created, err := r.client.CreateTCPCheck(ctx, check)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create TCP check, got error: %s", err))
 		return
 	}
+	created.Status = applyCheckPaused(ctx, r.client, data.Paused, created.ID, created.Status, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	populateTCPModel(ctx, &data, created, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *TCPCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -295,13 +424,14 @@ func (r *TCPCheckResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	check, err := r.client.GetTCPCheck(data.Id.ValueString())
+	check, err := r.client.GetTCPCheck(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read TCP check, got error: %s", err))
 		return
 	}
 	populateTCPModel(ctx, &data, check, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *TCPCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -313,13 +443,20 @@ func (r *TCPCheckResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	updated, err := r.client.UpdateTCPCheck(state.Id.ValueString(), tcpModelToClient(ctx, &data, &resp.Diagnostics))
+	updated, err := r.client.UpdateTCPCheck(ctx, state.Id.ValueString(), tcpModelToClient(ctx, &data, &resp.Diagnostics))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update TCP check, got error: %s", err))
 		return
 	}
+	updated.Status = applyCheckPaused(ctx, r.client, data.Paused, updated.ID, updated.Status, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plannedStatus := data.Status
 	populateTCPModel(ctx, &data, updated, &resp.Diagnostics)
+	data.keepPlannedStatus(plannedStatus)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *TCPCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -328,13 +465,52 @@ func (r *TCPCheckResource) Delete(ctx context.Context, req resource.DeleteReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := r.client.DeleteTCPCheck(data.Id.ValueString()); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete TCP check, got error: %s", err))
+	if err := destroyMonitor(ctx, data.OnDestroy, data.Id.ValueString(), r.client.DeleteTCPCheck, r.client.PauseCheck); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to %s TCP check, got error: %s", onDestroyMode(data.OnDestroy), err))
 	}
 }
 
+// findExistingCheck returns the ID of an existing TCP check with the same
+// name, hostname and port, or "" when there is none.
+func (r *TCPCheckResource) findExistingCheck(ctx context.Context, check *client.TCPCheck, diags *diag.Diagnostics) string {
+	checks, err := r.client.ListTCPChecks(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list TCP checks to adopt, got error: %s", err))
+		return ""
+	}
+
+	return findAdoptionCandidate(checks, "TCP check",
+		func(c client.TCPCheck) string { return c.ID },
+		func(c client.TCPCheck) bool {
+			return c.Name == check.Name && c.TCPHostname == check.TCPHostname && c.TCPPort == check.TCPPort
+		},
+		diags,
+	)
+}
+
 func (r *TCPCheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import format: check_id, name=<name> or hostname=<hostname>
+	importStateByLookup(ctx, req, resp, r.client.ListTCPChecks, objectLookup[client.TCPCheck]{
+		object: "TCP check",
+		id:     func(c client.TCPCheck) string { return c.ID },
+		describe: func(c client.TCPCheck) string {
+			return fmt.Sprintf("%s, %s", c.Name, fmt.Sprintf("%s:%d", c.TCPHostname, c.TCPPort))
+		},
+		fields: map[string]func(client.TCPCheck) string{
+			"name":     func(c client.TCPCheck) string { return c.Name },
+			"hostname": func(c client.TCPCheck) string { return c.TCPHostname },
+		},
+	})
+}
+
+// applyCheckPaused pauses or resumes a check to match the planned paused
+// value and returns its resulting status.
+func applyCheckPaused(ctx context.Context, c *client.Client, paused types.Bool, id, status string, diags *diag.Diagnostics) string {
+	status, err := applyPaused(ctx, paused, id, status, c.PauseCheck, c.ResumeCheck)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update paused state of check %s, got error: %s", id, err))
+	}
+	return status
 }
 
 func dnsModelToClient(ctx context.Context, data *DNSCheckModel, diags *diag.Diagnostics) *client.DNSCheck {
@@ -416,6 +592,8 @@ func listElementsAs(ctx context.Context, value types.List, target *[]string, dia
 
 func populateDNSModel(ctx context.Context, data *DNSCheckModel, check *client.DNSCheck, diags *diag.Diagnostics) {
 	populateCommonModel(ctx, &data.typedCheckModel, check.ID, check.Name, check.TestInterval, check.ReminderAlertIntervalMinutes, check.ConfirmationPeriodSeconds, check.RecoveryPeriodSeconds, check.Timeout, check.AlertPriority, check.TestRegions, check.UserAlerts, check.SlackAlerts, check.DiscordAlerts, check.TelegramAlerts, check.WebhookAlerts, check.OncallAlerts, check.IncidentIOAlerts, check.MicrosoftTeamsAlerts, check.Assertions, diags)
+	data.setStatus(check.Status)
+	data.LastQueued = optionalStringValue(check.LastQueued)
 	data.DNSDomain = types.StringValue(check.DNSDomain)
 	data.DNSRecordType = types.StringValue(check.DNSRecordType)
 	data.DNSProtocol = optionalStringValue(check.DNSProtocol)
@@ -428,6 +606,8 @@ func populateDNSModel(ctx context.Context, data *DNSCheckModel, check *client.DN
 
 func populateTCPModel(ctx context.Context, data *TCPCheckModel, check *client.TCPCheck, diags *diag.Diagnostics) {
 	populateCommonModel(ctx, &data.typedCheckModel, check.ID, check.Name, check.TestInterval, check.ReminderAlertIntervalMinutes, check.ConfirmationPeriodSeconds, check.RecoveryPeriodSeconds, check.Timeout, check.AlertPriority, check.TestRegions, check.UserAlerts, check.SlackAlerts, check.DiscordAlerts, check.TelegramAlerts, check.WebhookAlerts, check.OncallAlerts, check.IncidentIOAlerts, check.MicrosoftTeamsAlerts, check.Assertions, diags)
+	data.setStatus(check.Status)
+	data.LastQueued = optionalStringValue(check.LastQueued)
 	data.TCPHostname = types.StringValue(check.TCPHostname)
 	data.TCPPort = types.Int64Value(int64(check.TCPPort))
 	data.TCPIPFamily = optionalStringValue(check.TCPIPFamily)
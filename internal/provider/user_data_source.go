@@ -103,7 +103,7 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	}
 
 	// Fetch all users and find the matching one
-	users, err := d.client.ListUsers()
+	users, err := d.client.ListUsers(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read users, got error: %s", err))
 		return
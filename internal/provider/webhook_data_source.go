@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/provider/resource_webhook"
+)
+
+var _ datasource.DataSourceWithConfigValidators = &apiLookupDataSource[client.Webhook, resource_webhook.WebhookModel]{}
+
+func NewWebhookDataSource() datasource.DataSource {
+	return &apiLookupDataSource[client.Webhook, resource_webhook.WebhookModel]{
+		typeName: "webhook",
+		// Webhooks have no name, so the URL stands in for it
+		description: "Looks up a single webhook by `id` or `url`.",
+		resource:    NewWebhookResource,
+		list:        (*client.Client).ListWebhooks,
+		lookup: objectLookup[client.Webhook]{
+			object: "webhook",
+			id:     func(wh client.Webhook) string { return wh.ID },
+			describe: func(wh client.Webhook) string {
+				if wh.Description != "" {
+					return wh.Description
+				}
+				return wh.URL
+			},
+			fields: map[string]func(client.Webhook) string{
+				"id":  func(wh client.Webhook) string { return wh.ID },
+				"url": func(wh client.Webhook) string { return wh.URL },
+			},
+		},
+		model: func(ctx context.Context, wh client.Webhook, diags *diag.Diagnostics) resource_webhook.WebhookModel {
+			return webhookModelFromAPI(ctx, &wh, diags)
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/client"
+	"github.com/onlineornot/terraform-provider-onlineornot/internal/provider/resource_webhook"
+)
+
+var _ list.ListResourceWithConfigure = &apiListResource[client.Webhook, webhookListConfigModel]{}
+
+// webhookListConfigModel holds the filters of the webhook list block.
+// Webhooks have no name, so they are filtered by URL and event type instead.
+type webhookListConfigModel struct {
+	UrlPrefix types.String `tfsdk:"url_prefix"`
+	EventType types.String `tfsdk:"event_type"`
+}
+
+func NewWebhookListResource() list.ListResource {
+	return &apiListResource[client.Webhook, webhookListConfigModel]{
+		typeName: "webhook",
+		object:   "webhook",
+		schema: listschema.Schema{
+			Description: "Lists webhooks so they can be imported with `terraform query`.",
+			Attributes: map[string]listschema.Attribute{
+				"url_prefix": listschema.StringAttribute{
+					Optional:    true,
+					Description: "Only return webhooks whose URL starts with this prefix.",
+				},
+				"event_type": listschema.StringAttribute{
+					Optional:    true,
+					Description: "Only return webhooks subscribed to this event type.",
+				},
+			},
+		},
+		list: (*client.Client).ListWebhooks,
+		match: func(config webhookListConfigModel, wh client.Webhook) bool {
+			return matchesPrefix(config.UrlPrefix, wh.URL) &&
+				(config.EventType.IsNull() || slices.Contains(wh.Events, config.EventType.ValueString()))
+		},
+		id: func(wh client.Webhook) string { return wh.ID },
+		displayName: func(wh client.Webhook) string {
+			if wh.Description != "" {
+				return wh.Description
+			}
+			return wh.URL
+		},
+		model: func(ctx context.Context, wh client.Webhook, diags *diag.Diagnostics) any {
+			return webhookModelFromAPI(ctx, &wh, diags)
+		},
+	}
+}
+
+// webhookModelFromAPI builds a complete resource model from an API webhook
+func webhookModelFromAPI(ctx context.Context, wh *client.Webhook, diags *diag.Diagnostics) resource_webhook.WebhookModel {
+	return resource_webhook.WebhookModel{
+		Id:            types.StringValue(wh.ID),
+		Url:           types.StringValue(wh.URL),
+		Description:   optionalStringValue(wh.Description),
+		Events:        stringListValue(ctx, wh.Events, diags),
+		CheckIds:      stringListValue(ctx, wh.CheckIDs, diags),
+		HeartbeatIds:  stringListValue(ctx, wh.HeartbeatIDs, diags),
+		StatusPageIds: stringListValue(ctx, wh.StatusPageIDs, diags),
+	}
+}
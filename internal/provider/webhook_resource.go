@@ -14,6 +14,9 @@ import (
 
 var _ resource.Resource = &WebhookResource{}
 var _ resource.ResourceWithImportState = &WebhookResource{}
+var _ resource.ResourceWithIdentity = &WebhookResource{}
+
+const webhookSchemaVersion = 0
 
 func NewWebhookResource() resource.Resource {
 	return &WebhookResource{}
@@ -29,6 +32,11 @@ func (r *WebhookResource) Metadata(ctx context.Context, req resource.MetadataReq
 
 func (r *WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_webhook.WebhookResourceSchema(ctx)
+	resp.Schema.Version = webhookSchemaVersion
+}
+
+func (r *WebhookResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Webhook")
 }
 
 func (r *WebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -74,7 +82,7 @@ func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest
 		data.StatusPageIds.ElementsAs(ctx, &wh.StatusPageIDs, false)
 	}
 
-	created, err := r.client.CreateWebhook(wh)
+	created, err := r.client.CreateWebhook(ctx, wh)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook, got error: %s", err))
 		return
@@ -97,6 +105,7 @@ func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -107,7 +116,7 @@ func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	wh, err := r.client.GetWebhook(data.Id.ValueString())
+	wh, err := r.client.GetWebhook(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webhook, got error: %s", err))
 		return
@@ -118,6 +127,7 @@ func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 	data.Description = types.StringValue(wh.Description)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -146,13 +156,14 @@ func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest
 		data.StatusPageIds.ElementsAs(ctx, &wh.StatusPageIDs, false)
 	}
 
-	_, err := r.client.UpdateWebhook(data.Id.ValueString(), wh)
+	_, err := r.client.UpdateWebhook(ctx, data.Id.ValueString(), wh)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update webhook, got error: %s", err))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -163,7 +174,7 @@ func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	err := r.client.DeleteWebhook(data.Id.ValueString())
+	err := r.client.DeleteWebhook(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete webhook, got error: %s", err))
 		return
@@ -171,5 +182,5 @@ func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }